@@ -0,0 +1,285 @@
+package genetic_algorithm
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"io"
+)
+
+// Components which state can be saved in a checkpoint and restored on resume.
+// Statistics, stop criteria and constraint handlers may implement it.
+type CheckpointableInterface interface {
+	MarshalCheckpoint() ([]byte, error)
+	UnmarshalCheckpoint([]byte) error
+}
+
+// Creates writer for the checkpoint of the generation.
+// If writer implements io.Closer it will be closed after the checkpoint is written.
+type CheckpointWriterFunction func(generation int) (io.Writer, error)
+
+const checkpointVersion = 1
+
+type checkpointChromosome struct {
+	Genes []byte
+	Cost  float64
+	Dirty bool
+
+	Constrained *ConstrainedCost
+	// Costs kept by optimizer besides Cost, e.g. objectives of multi-objective optimizer
+	Costs []float64
+}
+
+// Optional virtual methods for optimizers that keep costs of chromosomes besides Cost.
+// The costs are saved in checkpoints.
+type chromosomeCostsVirtualMInterface interface {
+	chromosomeCosts(chrom ChromosomeInterface) ([]float64, bool)
+	restoreChromosomeCosts(chrom ChromosomeInterface, costs []float64)
+}
+
+type optimizerCheckpoint struct {
+	Version    int
+	Generation int
+
+	Serializer  string
+	Chromosomes []checkpointChromosome
+	// Indexes of chromosomes, the same chromosome can take several places in population
+	Population []int
+
+	// The best chromosome found before the checkpoint
+	Best *checkpointChromosome
+
+	Statistics        []byte
+	StopCriterion     []byte
+	ConstraintHandler []byte
+
+	HasRandom   bool
+	RandomState []byte
+}
+
+func (optimizer *OptimizerBase) writeCheckpoint(w io.Writer) error {
+	serializer, err := optimizer.serializer(optimizer.population[0])
+	if err != nil {
+		return err
+	}
+
+	checkpoint := new(optimizerCheckpoint)
+	checkpoint.Version = checkpointVersion
+	checkpoint.Generation = optimizer.generation
+	checkpoint.Serializer = serializer.Name()
+
+	indexes := make(map[ChromosomeInterface]int, len(optimizer.population))
+	checkpoint.Population = make([]int, len(optimizer.population))
+	for i, chrom := range optimizer.population {
+		ind, ok := indexes[chrom]
+		if !ok {
+			saved, err := optimizer.checkpointChromosome(serializer, chrom)
+			if err != nil {
+				return err
+			}
+
+			ind = len(checkpoint.Chromosomes)
+			indexes[chrom] = ind
+			checkpoint.Chromosomes = append(checkpoint.Chromosomes, *saved)
+		}
+		checkpoint.Population[i] = ind
+	}
+
+	if optimizer.best != nil {
+		if checkpoint.Best, err = optimizer.checkpointChromosome(serializer, optimizer.best); err != nil {
+			return err
+		}
+		if optimizer.constrainedCostFunction != nil {
+			checkpoint.Best.Constrained = &optimizer.bestConstrained
+		}
+	}
+
+	if checkpoint.Statistics, err = marshalCheckpoint(optimizer.statistics); err != nil {
+		return err
+	}
+	if checkpoint.StopCriterion, err = marshalCheckpoint(optimizer.stopCriterion); err != nil {
+		return err
+	}
+	if checkpoint.ConstraintHandler, err = marshalCheckpoint(optimizer.constraintHandler); err != nil {
+		return err
+	}
+
+	if optimizer.source != nil {
+		checkpoint.HasRandom = true
+		if checkpoint.RandomState, err = optimizer.source.MarshalBinary(); err != nil {
+			return err
+		}
+	}
+
+	return gob.NewEncoder(w).Encode(checkpoint)
+}
+func (optimizer *OptimizerBase) checkpointChromosome(serializer ChromosomeSerializerInterface, chrom ChromosomeInterface) (*checkpointChromosome, error) {
+	genes, err := serializer.Marshal(chrom)
+	if err != nil {
+		return nil, err
+	}
+
+	saved := &checkpointChromosome{
+		Genes: genes,
+		Cost:  chrom.Cost(),
+		Dirty: isDirty(chrom),
+	}
+	if constrained, ok := optimizer.constrainedCosts[chrom]; ok {
+		saved.Constrained = &constrained
+	}
+	if virtual, ok := optimizer.OptimizerBaseVirtualMInterface.(chromosomeCostsVirtualMInterface); ok {
+		saved.Costs, _ = virtual.chromosomeCosts(chrom)
+	}
+	return saved, nil
+}
+func (optimizer *OptimizerBase) readCheckpoint(r io.Reader) (*optimizerCheckpoint, error) {
+	checkpoint := new(optimizerCheckpoint)
+	if err := gob.NewDecoder(r).Decode(checkpoint); err != nil {
+		return nil, err
+	}
+
+	if checkpoint.Version != checkpointVersion {
+		return nil, fmt.Errorf("Unsupported checkpoint version %d", checkpoint.Version)
+	}
+	if len(checkpoint.Population) == 0 {
+		return nil, fmt.Errorf("Checkpoint population is empty")
+	}
+
+	return checkpoint, nil
+}
+
+// Restores state saved in checkpoint.
+// Must be called after statistics are created.
+func (optimizer *OptimizerBase) restoreCheckpoint(checkpoint *optimizerCheckpoint) error {
+	serializer, err := optimizer.serializerByName(checkpoint.Serializer)
+	if err != nil {
+		return err
+	}
+
+	optimizer.constrainedCosts = nil
+	chroms := make(Chromosomes, len(checkpoint.Chromosomes))
+	for i := range checkpoint.Chromosomes {
+		if chroms[i], err = optimizer.restoreChromosome(serializer, &checkpoint.Chromosomes[i]); err != nil {
+			return err
+		}
+	}
+
+	optimizer.population = make(Chromosomes, len(checkpoint.Population))
+	for i, ind := range checkpoint.Population {
+		if ind < 0 || ind >= len(chroms) {
+			return fmt.Errorf("Checkpoint population refers to unknown chromosome %d", ind)
+		}
+		optimizer.population[i] = chroms[ind]
+	}
+
+	optimizer.generation = checkpoint.Generation
+
+	if checkpoint.Best != nil {
+		if optimizer.best, err = optimizer.restoreChromosome(serializer, checkpoint.Best); err != nil {
+			return err
+		}
+		if checkpoint.Best.Constrained != nil {
+			optimizer.bestConstrained = *checkpoint.Best.Constrained
+		}
+	}
+
+	if err = unmarshalCheckpoint(optimizer.statistics, checkpoint.Statistics); err != nil {
+		return err
+	}
+	if err = unmarshalCheckpoint(optimizer.stopCriterion, checkpoint.StopCriterion); err != nil {
+		return err
+	}
+	if err = unmarshalCheckpoint(optimizer.constraintHandler, checkpoint.ConstraintHandler); err != nil {
+		return err
+	}
+
+	if checkpoint.HasRandom {
+		if optimizer.source == nil {
+			optimizer.Seed(0)
+			optimizer.setRand()
+		}
+		if err = optimizer.source.UnmarshalBinary(checkpoint.RandomState); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+func (optimizer *OptimizerBase) restoreChromosome(serializer ChromosomeSerializerInterface, saved *checkpointChromosome) (ChromosomeInterface, error) {
+	chrom, err := serializer.Unmarshal(saved.Genes)
+	if err != nil {
+		return nil, err
+	}
+	if saved.Dirty {
+		return chrom, nil
+	}
+
+	chrom.SetCost(saved.Cost)
+	if saved.Constrained != nil {
+		if optimizer.constrainedCosts == nil {
+			optimizer.constrainedCosts = make(map[ChromosomeInterface]ConstrainedCost)
+		}
+		optimizer.constrainedCosts[chrom] = *saved.Constrained
+	}
+	if virtual, ok := optimizer.OptimizerBaseVirtualMInterface.(chromosomeCostsVirtualMInterface); ok && saved.Costs != nil {
+		virtual.restoreChromosomeCosts(chrom, saved.Costs)
+	}
+	return chrom, nil
+}
+func (optimizer *OptimizerBase) serializer(chrom ChromosomeInterface) (ChromosomeSerializerInterface, error) {
+	if optimizer.chromosomeSerializer != nil {
+		return optimizer.chromosomeSerializer, nil
+	}
+
+	serializer, ok := defaultChromosomeSerializer(chrom)
+	if !ok {
+		return nil, fmt.Errorf("No serializer for %T, set it with ChromosomeSerializer", chrom)
+	}
+	return serializer, nil
+}
+func (optimizer *OptimizerBase) serializerByName(name string) (ChromosomeSerializerInterface, error) {
+	if optimizer.chromosomeSerializer != nil {
+		if optimizer.chromosomeSerializer.Name() != name {
+			return nil, fmt.Errorf("Checkpoint was made with %q serializer, but %q is set",
+				name, optimizer.chromosomeSerializer.Name())
+		}
+		return optimizer.chromosomeSerializer, nil
+	}
+
+	serializer, ok := chromosomeSerializers[name]
+	if !ok {
+		return nil, fmt.Errorf("No serializer %q, set it with ChromosomeSerializer", name)
+	}
+	return serializer, nil
+}
+
+func marshalCheckpoint(component interface{}) ([]byte, error) {
+	checkpointable, ok := component.(CheckpointableInterface)
+	if !ok {
+		return nil, nil
+	}
+	return checkpointable.MarshalCheckpoint()
+}
+func unmarshalCheckpoint(component interface{}, data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	checkpointable, ok := component.(CheckpointableInterface)
+	if !ok {
+		return fmt.Errorf("Checkpoint has state for %T, but it can't be restored", component)
+	}
+	return checkpointable.UnmarshalCheckpoint(data)
+}
+
+// Gob encoding of state of checkpointable components
+func encodeCheckpoint(state interface{}) ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(state); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+func decodeCheckpoint(data []byte, state interface{}) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(state)
+}
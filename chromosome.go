@@ -3,7 +3,6 @@ package genetic_algorithm
 import (
 	"bytes"
 	"fmt"
-	log "github.com/cihub/seelog"
 )
 
 type EmptyChromosomeConstructor func(genesLen int) ChromosomeInterface
@@ -14,19 +13,103 @@ type ChromosomeInterface interface {
 	Cost() float64
 }
 
+// Chromosomes that know whether genes were changed since the last cost evaluation.
+// Optimizer evaluates cost only for dirty ones. Chromosomes that don't implement this interface
+// are evaluated every generation.
+// Operators that modify genes of existing chromosomes must call MarkDirty.
+type DirtyChromosomeInterface interface {
+	Dirty() bool
+	MarkDirty()
+}
+
+// Marks chromosome as modified if it tracks modifications
+func MarkDirty(chrom ChromosomeInterface) {
+	if dirtyChrom, ok := chrom.(DirtyChromosomeInterface); ok {
+		dirtyChrom.MarkDirty()
+	}
+}
+
+// Chromosomes that don't track changes are always dirty
+func isDirty(chrom ChromosomeInterface) bool {
+	if dirtyChrom, ok := chrom.(DirtyChromosomeInterface); ok {
+		return dirtyChrom.Dirty()
+	}
+	return true
+}
+
+// Chromosomes that can make an independent copy of themselves.
+// Optimizers copy chromosomes which must stay unchanged while operators modify genes in place.
+type CopyableChromosomeInterface interface {
+	Copy() ChromosomeInterface
+}
+
+// Returns copy of the chromosome or the chromosome itself if it can't be copied
+func copyOf(chrom ChromosomeInterface) ChromosomeInterface {
+	if copyable, ok := chrom.(CopyableChromosomeInterface); ok {
+		return copyable.Copy()
+	}
+	return chrom
+}
+func copiesOf(chroms Chromosomes) Chromosomes {
+	copies := make(Chromosomes, len(chroms))
+	for i, chrom := range chroms {
+		copies[i] = copyOf(chrom)
+	}
+	return copies
+}
+
 type Chromosomes []ChromosomeInterface
 
 func (c Chromosomes) Len() int           { return len(c) }
 func (c Chromosomes) Less(i, j int) bool { return c[i].Cost() < c[j].Cost() }
 func (c Chromosomes) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
 func (c Chromosomes) SetCost(cost CostFunction) {
-	log.Tracef("Setting cost for %d chroms", len(c))
-
 	for i := 0; i < len(c); i++ {
 		var chrom = c[i]
 		chrom.SetCost(cost(chrom))
 	}
 }
+
+// Returns chromosomes which cost must be evaluated. Each chromosome is returned once.
+func (c Chromosomes) Dirty() Chromosomes {
+	dirty := make(Chromosomes, 0, len(c))
+	seen := make(map[ChromosomeInterface]bool, len(c))
+
+	for _, chrom := range c {
+		if !isDirty(chrom) || seen[chrom] {
+			continue
+		}
+
+		seen[chrom] = true
+		dirty = append(dirty, chrom)
+	}
+
+	return dirty
+}
+
+// Evaluates cost function in several goroutines.
+// Cost function must be safe for concurrent use.
+// Costs are assigned in the calling goroutine, so the result doesn't depend on the evaluation order.
+func (c Chromosomes) SetCostParallel(cost CostFunction, workers int) {
+	if workers <= 1 || len(c) <= 1 {
+		c.SetCost(cost)
+		return
+	}
+
+	indexes := make([]int, len(c))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	costs := make([]float64, len(c))
+	parallelFor(indexes, workers, func(i int) {
+		costs[i] = cost(c[i])
+	})
+
+	for i := 0; i < len(c); i++ {
+		c[i].SetCost(costs[i])
+	}
+}
 func (c Chromosomes) MeanCost() float64 {
 	if len(c) == 0 {
 		return 0
@@ -1,18 +1,37 @@
 package genetic_algorithm
 
 // Base class for chromosomes.
+// Tracks whether genes were changed since the last cost evaluation.
 type ChromosomeBase struct {
 	costVal    float64
 	fitnessVal float64
+	dirty      bool
 }
 
 func NewChromosomeBase() *ChromosomeBase {
-	return &ChromosomeBase{}
+	return &ChromosomeBase{dirty: true}
 }
 func (chrom *ChromosomeBase) SetCost(cost float64) {
 	chrom.costVal = cost
 	chrom.fitnessVal = 0
+	chrom.dirty = false
 }
 func (chrom *ChromosomeBase) Cost() float64 {
 	return chrom.costVal
 }
+
+// Whether or not cost must be reevaluated
+func (chrom *ChromosomeBase) Dirty() bool {
+	return chrom.dirty
+}
+
+// Should be called after genes modification
+func (chrom *ChromosomeBase) MarkDirty() {
+	chrom.dirty = true
+}
+
+// Copy with the same cost and dirty state
+func (chrom *ChromosomeBase) copyBase() *ChromosomeBase {
+	copied := *chrom
+	return &copied
+}
@@ -19,6 +19,25 @@ func (g BinaryGenes) Copy(genes GenesInterface, from1, from2, to2 int) int {
 
 	return copy(g[from1:], bgenes[from2:to2])
 }
+func (g BinaryGenes) Hash() uint64 {
+	return hashBools([]bool(g))
+}
+
+// Hamming distance
+func (g BinaryGenes) Distance(genes GenesInterface) float64 {
+	other, ok := genes.(BinaryGenes)
+	if !ok {
+		panic("Unexpected genes. Expected BinaryGenes")
+	}
+
+	distance := 0
+	for i := 0; i < len(g); i++ {
+		if g[i] != other[i] {
+			distance++
+		}
+	}
+	return float64(distance)
+}
 
 type BinaryChromosome struct {
 	*ChromosomeBase
@@ -36,6 +55,13 @@ func NewBinaryChromosome(genes BinaryGenes) *BinaryChromosome {
 func NewEmptyBinaryChromosome(genesLen int) ChromosomeInterface {
 	return NewBinaryChromosome(make(BinaryGenes, genesLen))
 }
+
+// Returns independent copy with the same genes and cost
+func (chrom *BinaryChromosome) Copy() ChromosomeInterface {
+	copied := NewBinaryChromosome(append(BinaryGenes(nil), chrom.genes...))
+	copied.ChromosomeBase = chrom.ChromosomeBase.copyBase()
+	return copied
+}
 func (chrom *BinaryChromosome) Genes() GenesInterface {
 	return chrom.genes
 }
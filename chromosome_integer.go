@@ -0,0 +1,189 @@
+package genetic_algorithm
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+)
+
+type IntegerGenes []int
+
+func (g IntegerGenes) Len() int                   { return len(g) }
+func (g IntegerGenes) Swap(i, j int)              { g[i], g[j] = g[j], g[i] }
+func (g IntegerGenes) Get(i int) interface{}      { return g[i] }
+func (g IntegerGenes) Set(i int, val interface{}) { g[i] = val.(int) }
+func (g IntegerGenes) Copy(genes GenesInterface, from1, from2, to2 int) int {
+	igenes, ok := genes.(IntegerGenes)
+	if !ok {
+		panic("Unexpected genes. Expected IntegerGenes")
+	}
+
+	return copy(g[from1:], igenes[from2:to2])
+}
+func (g IntegerGenes) Hash() uint64 {
+	return hashInts([]int(g))
+}
+
+// Manhattan distance
+func (g IntegerGenes) Distance(genes GenesInterface) float64 {
+	other, ok := genes.(IntegerGenes)
+	if !ok {
+		panic("Unexpected genes. Expected IntegerGenes")
+	}
+
+	distance := 0
+	for i := 0; i < len(g); i++ {
+		if g[i] > other[i] {
+			distance += g[i] - other[i]
+		} else {
+			distance += other[i] - g[i]
+		}
+	}
+	return float64(distance)
+}
+
+// Lower and upper limits of integer genes.
+// Bounds are inclusive.
+type IntegerBounds struct {
+	configChecker
+
+	lower []int
+	upper []int
+}
+
+// Creates bounds with separate limits for each gene
+func NewIntegerBounds(lower, upper []int) *IntegerBounds {
+	bounds := new(IntegerBounds)
+
+	bounds.setup(lower, upper)
+
+	return bounds
+}
+
+// Creates bounds with the same limits for all genes
+func NewUniformIntegerBounds(lower, upper int) *IntegerBounds {
+	return NewIntegerBounds([]int{lower}, []int{upper})
+}
+
+// Creates bounds [0:sizes[i]-1] for each gene, i.e. gene i takes one of sizes[i] values
+func NewIntegerAlphabetBounds(sizes []int) *IntegerBounds {
+	bounds := new(IntegerBounds)
+
+	lower := make([]int, len(sizes))
+	upper := make([]int, len(sizes))
+	for i, size := range sizes {
+		if size <= 0 {
+			bounds.invalid("IntegerBounds", "Alphabet size must be positive. Got %d for gene %d", size, i)
+		}
+		upper[i] = size - 1
+	}
+
+	bounds.setup(lower, upper)
+
+	return bounds
+}
+func (bounds *IntegerBounds) setup(lower, upper []int) {
+	if len(lower) == 0 || len(lower) != len(upper) {
+		bounds.invalid("IntegerBounds", "Lower and upper bounds must be non empty and have the same length")
+	}
+	for i := 0; i < len(lower) && i < len(upper); i++ {
+		if lower[i] > upper[i] {
+			bounds.invalid("IntegerBounds", "Incorrect bounds [%d:%d] for gene %d", lower[i], upper[i], i)
+		}
+	}
+
+	bounds.lower = lower
+	bounds.upper = upper
+}
+
+func (bounds *IntegerBounds) Lower(ind int) int {
+	if len(bounds.lower) == 1 {
+		return bounds.lower[0]
+	}
+	return bounds.lower[ind]
+}
+func (bounds *IntegerBounds) Upper(ind int) int {
+	if len(bounds.upper) == 1 {
+		return bounds.upper[0]
+	}
+	return bounds.upper[ind]
+}
+
+// Returns number of values specified gene can take
+func (bounds *IntegerBounds) Size(ind int) int {
+	return bounds.Upper(ind) - bounds.Lower(ind) + 1
+}
+
+// Moves value inside bounds of specified gene
+func (bounds *IntegerBounds) Clamp(ind int, val int) int {
+	if val < bounds.Lower(ind) {
+		return bounds.Lower(ind)
+	}
+	if val > bounds.Upper(ind) {
+		return bounds.Upper(ind)
+	}
+	return val
+}
+
+// Whether or not bounds can be applied to chromosome with specified length
+func (bounds *IntegerBounds) Fits(genesLen int) bool {
+	return len(bounds.lower) == 1 || len(bounds.lower) == genesLen
+}
+
+type IntegerChromosome struct {
+	*ChromosomeBase
+	genes  IntegerGenes
+	bounds *IntegerBounds
+}
+
+func NewIntegerChromosome(genes IntegerGenes, bounds *IntegerBounds) *IntegerChromosome {
+	if bounds == nil {
+		panic(newConfigError("IntegerChromosome", "Bounds must be set"))
+	}
+	if !bounds.Fits(len(genes)) {
+		panic(newConfigError("IntegerChromosome", "Bounds do not fit chromosome of length %d", len(genes)))
+	}
+
+	chrom := new(IntegerChromosome)
+
+	chrom.ChromosomeBase = NewChromosomeBase()
+	chrom.genes = genes
+	chrom.bounds = bounds
+
+	return chrom
+}
+
+// Returns constructor of empty integer chromosomes with specified bounds
+func NewEmptyIntegerChromosomeConstructor(bounds *IntegerBounds) EmptyChromosomeConstructor {
+	return func(genesLen int) ChromosomeInterface {
+		return NewIntegerChromosome(make(IntegerGenes, genesLen), bounds)
+	}
+}
+
+// Returns independent copy with the same genes and cost
+func (chrom *IntegerChromosome) Copy() ChromosomeInterface {
+	copied := NewIntegerChromosome(append(IntegerGenes(nil), chrom.genes...), chrom.bounds)
+	copied.ChromosomeBase = chrom.ChromosomeBase.copyBase()
+	return copied
+}
+func (chrom *IntegerChromosome) Genes() GenesInterface {
+	return chrom.genes
+}
+func (chrom *IntegerChromosome) IntegerGenes() IntegerGenes {
+	return chrom.genes
+}
+func (chrom *IntegerChromosome) Bounds() *IntegerBounds {
+	return chrom.bounds
+}
+func (chrom *IntegerChromosome) String() string {
+	var buffer bytes.Buffer
+
+	for i, g := range chrom.genes {
+		if i != 0 {
+			buffer.WriteString(" ")
+		}
+
+		buffer.WriteString(strconv.Itoa(g))
+	}
+	return fmt.Sprintf("IC genes:[%v], cost: %f", buffer.String(), chrom.costVal)
+}
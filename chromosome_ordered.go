@@ -20,6 +20,33 @@ func (g OrderedGenes) Copy(genes GenesInterface, from1, from2, to2 int) int {
 
 	return copy(g[from1:], bgenes[from2:to2])
 }
+func (g OrderedGenes) Hash() uint64 {
+	return hashInts([]int(g))
+}
+
+// Kendall tau distance, number of gene pairs which are ordered differently.
+// Expects permutations of the same genes.
+func (g OrderedGenes) Distance(genes GenesInterface) float64 {
+	other, ok := genes.(OrderedGenes)
+	if !ok {
+		panic("Unexpected genes. Expected OrderedGenes")
+	}
+
+	positions := make([]int, len(other))
+	for i, gene := range other {
+		positions[gene] = i
+	}
+
+	distance := 0
+	for i := 0; i < len(g); i++ {
+		for j := i + 1; j < len(g); j++ {
+			if positions[g[i]] > positions[g[j]] {
+				distance++
+			}
+		}
+	}
+	return float64(distance)
+}
 func (g OrderedGenes) Ind(val int) int {
 	for i := 0; i < len(g); i++ {
 		if g[i] == val {
@@ -49,6 +76,13 @@ func NewEmptyOrderedChromosome(genesLen int) ChromosomeInterface {
 	}
 	return NewOrderedChromosome(genes)
 }
+
+// Returns independent copy with the same genes and cost
+func (chrom *OrderedChromosome) Copy() ChromosomeInterface {
+	copied := NewOrderedChromosome(append(OrderedGenes(nil), chrom.genes...))
+	copied.ChromosomeBase = chrom.ChromosomeBase.copyBase()
+	return copied
+}
 func (chrom *OrderedChromosome) Genes() GenesInterface {
 	return chrom.genes
 }
@@ -0,0 +1,178 @@
+package genetic_algorithm
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+type RealGenes []float64
+
+func (g RealGenes) Len() int                   { return len(g) }
+func (g RealGenes) Swap(i, j int)              { g[i], g[j] = g[j], g[i] }
+func (g RealGenes) Get(i int) interface{}      { return g[i] }
+func (g RealGenes) Set(i int, val interface{}) { g[i] = val.(float64) }
+func (g RealGenes) Copy(genes GenesInterface, from1, from2, to2 int) int {
+	rgenes, ok := genes.(RealGenes)
+	if !ok {
+		panic("Unexpected genes. Expected RealGenes")
+	}
+
+	return copy(g[from1:], rgenes[from2:to2])
+}
+func (g RealGenes) Hash() uint64 {
+	return hashFloats([]float64(g))
+}
+
+// Euclidean distance
+func (g RealGenes) Distance(genes GenesInterface) float64 {
+	other, ok := genes.(RealGenes)
+	if !ok {
+		panic("Unexpected genes. Expected RealGenes")
+	}
+
+	var sum float64
+	for i := 0; i < len(g); i++ {
+		diff := g[i] - other[i]
+		sum += diff * diff
+	}
+	return math.Sqrt(sum)
+}
+func realGenesEqual(g1, g2 RealGenes) bool {
+	if len(g1) != len(g2) {
+		return false
+	}
+
+	for i := 0; i < len(g1); i++ {
+		if g1[i] != g2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Lower and upper limits of real genes.
+// Bounds are inclusive.
+type RealBounds struct {
+	configChecker
+
+	lower []float64
+	upper []float64
+}
+
+// Creates bounds with separate limits for each gene
+func NewRealBounds(lower, upper []float64) *RealBounds {
+	bounds := new(RealBounds)
+
+	if len(lower) == 0 || len(lower) != len(upper) {
+		bounds.invalid("RealBounds", "Lower and upper bounds must be non empty and have the same length")
+	}
+	for i := 0; i < len(lower) && i < len(upper); i++ {
+		if lower[i] > upper[i] || math.IsNaN(lower[i]) || math.IsNaN(upper[i]) {
+			bounds.invalid("RealBounds", "Incorrect bounds [%v:%v] for gene %d", lower[i], upper[i], i)
+		}
+	}
+
+	bounds.lower = lower
+	bounds.upper = upper
+
+	return bounds
+}
+
+// Creates bounds with the same limits for all genes
+func NewUniformRealBounds(lower, upper float64) *RealBounds {
+	return NewRealBounds([]float64{lower}, []float64{upper})
+}
+
+func (bounds *RealBounds) Lower(ind int) float64 {
+	if len(bounds.lower) == 1 {
+		return bounds.lower[0]
+	}
+	return bounds.lower[ind]
+}
+func (bounds *RealBounds) Upper(ind int) float64 {
+	if len(bounds.upper) == 1 {
+		return bounds.upper[0]
+	}
+	return bounds.upper[ind]
+}
+
+// Returns upper-lower for specified gene
+func (bounds *RealBounds) Range(ind int) float64 {
+	return bounds.Upper(ind) - bounds.Lower(ind)
+}
+
+// Moves value inside bounds of specified gene
+func (bounds *RealBounds) Clamp(ind int, val float64) float64 {
+	if val < bounds.Lower(ind) {
+		return bounds.Lower(ind)
+	}
+	if val > bounds.Upper(ind) {
+		return bounds.Upper(ind)
+	}
+	return val
+}
+
+// Whether or not bounds can be applied to chromosome with specified length
+func (bounds *RealBounds) Fits(genesLen int) bool {
+	return len(bounds.lower) == 1 || len(bounds.lower) == genesLen
+}
+
+type RealChromosome struct {
+	*ChromosomeBase
+	genes  RealGenes
+	bounds *RealBounds
+}
+
+func NewRealChromosome(genes RealGenes, bounds *RealBounds) *RealChromosome {
+	if bounds == nil {
+		panic(newConfigError("RealChromosome", "Bounds must be set"))
+	}
+	if !bounds.Fits(len(genes)) {
+		panic(newConfigError("RealChromosome", "Bounds do not fit chromosome of length %d", len(genes)))
+	}
+
+	chrom := new(RealChromosome)
+
+	chrom.ChromosomeBase = NewChromosomeBase()
+	chrom.genes = genes
+	chrom.bounds = bounds
+
+	return chrom
+}
+
+// Returns constructor of empty real chromosomes with specified bounds
+func NewEmptyRealChromosomeConstructor(bounds *RealBounds) EmptyChromosomeConstructor {
+	return func(genesLen int) ChromosomeInterface {
+		return NewRealChromosome(make(RealGenes, genesLen), bounds)
+	}
+}
+
+// Returns independent copy with the same genes and cost
+func (chrom *RealChromosome) Copy() ChromosomeInterface {
+	copied := NewRealChromosome(append(RealGenes(nil), chrom.genes...), chrom.bounds)
+	copied.ChromosomeBase = chrom.ChromosomeBase.copyBase()
+	return copied
+}
+func (chrom *RealChromosome) Genes() GenesInterface {
+	return chrom.genes
+}
+func (chrom *RealChromosome) RealGenes() RealGenes {
+	return chrom.genes
+}
+func (chrom *RealChromosome) Bounds() *RealBounds {
+	return chrom.bounds
+}
+func (chrom *RealChromosome) String() string {
+	var buffer bytes.Buffer
+
+	for i, g := range chrom.genes {
+		if i != 0 {
+			buffer.WriteString(" ")
+		}
+
+		buffer.WriteString(strconv.FormatFloat(g, 'g', -1, 64))
+	}
+	return fmt.Sprintf("RC genes:[%v], cost: %f", buffer.String(), chrom.costVal)
+}
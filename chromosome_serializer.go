@@ -0,0 +1,178 @@
+package genetic_algorithm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// Converts genes of chromosomes to bytes and back.
+// Used to save population in checkpoints.
+type ChromosomeSerializerInterface interface {
+	// Name is saved in checkpoint and used to choose serializer on resume
+	Name() string
+	Marshal(ChromosomeInterface) ([]byte, error)
+	Unmarshal([]byte) (ChromosomeInterface, error)
+}
+
+var (
+	BinaryChromosomeSerializer  = &binaryChromosomeSerializer{}
+	OrderedChromosomeSerializer = &orderedChromosomeSerializer{}
+
+	chromosomeSerializers = map[string]ChromosomeSerializerInterface{
+		BinaryChromosomeSerializer.Name():  BinaryChromosomeSerializer,
+		OrderedChromosomeSerializer.Name(): OrderedChromosomeSerializer,
+	}
+)
+
+// Returns serializer for chromosomes that can be serialized out of the box
+func defaultChromosomeSerializer(chrom ChromosomeInterface) (ChromosomeSerializerInterface, bool) {
+	switch chrom.(type) {
+	case *BinaryChromosome:
+		return BinaryChromosomeSerializer, true
+	case *OrderedChromosome:
+		return OrderedChromosomeSerializer, true
+	}
+	return nil, false
+}
+
+type binaryChromosomeSerializer struct{}
+
+func (serializer *binaryChromosomeSerializer) Name() string {
+	return "binary"
+}
+func (serializer *binaryChromosomeSerializer) Marshal(chrom ChromosomeInterface) ([]byte, error) {
+	bc, ok := chrom.(*BinaryChromosome)
+	if !ok {
+		return nil, fmt.Errorf("Expects BinaryChromosome, got %T", chrom)
+	}
+
+	data := make([]byte, len(bc.genes))
+	for i, g := range bc.genes {
+		if g {
+			data[i] = 1
+		}
+	}
+	return data, nil
+}
+func (serializer *binaryChromosomeSerializer) Unmarshal(data []byte) (ChromosomeInterface, error) {
+	genes := make(BinaryGenes, len(data))
+	for i, b := range data {
+		genes[i] = b == 1
+	}
+	return NewBinaryChromosome(genes), nil
+}
+
+type orderedChromosomeSerializer struct{}
+
+func (serializer *orderedChromosomeSerializer) Name() string {
+	return "ordered"
+}
+func (serializer *orderedChromosomeSerializer) Marshal(chrom ChromosomeInterface) ([]byte, error) {
+	oc, ok := chrom.(*OrderedChromosome)
+	if !ok {
+		return nil, fmt.Errorf("Expects OrderedChromosome, got %T", chrom)
+	}
+
+	return marshalInts(oc.genes), nil
+}
+func (serializer *orderedChromosomeSerializer) Unmarshal(data []byte) (ChromosomeInterface, error) {
+	genes, err := unmarshalInts(data)
+	if err != nil {
+		return nil, err
+	}
+	return NewOrderedChromosome(OrderedGenes(genes)), nil
+}
+
+// Serializer for integer chromosomes. Bounds aren't saved and must be passed on creation.
+type IntegerChromosomeSerializer struct {
+	bounds *IntegerBounds
+}
+
+func NewIntegerChromosomeSerializer(bounds *IntegerBounds) *IntegerChromosomeSerializer {
+	serializer := new(IntegerChromosomeSerializer)
+
+	serializer.bounds = bounds
+
+	return serializer
+}
+func (serializer *IntegerChromosomeSerializer) Name() string {
+	return "integer"
+}
+func (serializer *IntegerChromosomeSerializer) Marshal(chrom ChromosomeInterface) ([]byte, error) {
+	ic, ok := chrom.(*IntegerChromosome)
+	if !ok {
+		return nil, fmt.Errorf("Expects IntegerChromosome, got %T", chrom)
+	}
+
+	return marshalInts(ic.genes), nil
+}
+func (serializer *IntegerChromosomeSerializer) Unmarshal(data []byte) (ChromosomeInterface, error) {
+	genes, err := unmarshalInts(data)
+	if err != nil {
+		return nil, err
+	}
+	return NewIntegerChromosome(IntegerGenes(genes), serializer.bounds), nil
+}
+
+// Serializer for real chromosomes. Bounds aren't saved and must be passed on creation.
+type RealChromosomeSerializer struct {
+	bounds *RealBounds
+}
+
+func NewRealChromosomeSerializer(bounds *RealBounds) *RealChromosomeSerializer {
+	serializer := new(RealChromosomeSerializer)
+
+	serializer.bounds = bounds
+
+	return serializer
+}
+func (serializer *RealChromosomeSerializer) Name() string {
+	return "real"
+}
+func (serializer *RealChromosomeSerializer) Marshal(chrom ChromosomeInterface) ([]byte, error) {
+	rc, ok := chrom.(*RealChromosome)
+	if !ok {
+		return nil, fmt.Errorf("Expects RealChromosome, got %T", chrom)
+	}
+
+	data := make([]byte, 8*len(rc.genes))
+	for i, g := range rc.genes {
+		binary.LittleEndian.PutUint64(data[8*i:], math.Float64bits(g))
+	}
+	return data, nil
+}
+func (serializer *RealChromosomeSerializer) Unmarshal(data []byte) (ChromosomeInterface, error) {
+	if len(data)%8 != 0 {
+		return nil, fmt.Errorf("Unexpected real genes length %d", len(data))
+	}
+
+	genes := make(RealGenes, len(data)/8)
+	for i := 0; i < len(genes); i++ {
+		genes[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[8*i:]))
+	}
+	return NewRealChromosome(genes, serializer.bounds), nil
+}
+
+func marshalInts(values []int) []byte {
+	data := make([]byte, 0, len(values)*binary.MaxVarintLen64)
+	buf := make([]byte, binary.MaxVarintLen64)
+	for _, val := range values {
+		n := binary.PutVarint(buf, int64(val))
+		data = append(data, buf[:n]...)
+	}
+	return data
+}
+func unmarshalInts(data []byte) ([]int, error) {
+	values := make([]int, 0)
+	reader := bytes.NewReader(data)
+	for reader.Len() != 0 {
+		val, err := binary.ReadVarint(reader)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, int(val))
+	}
+	return values, nil
+}
@@ -0,0 +1,326 @@
+package genetic_algorithm
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"sort"
+	"text/tabwriter"
+)
+
+// Runs several optimizer configurations and compares distributions of their final costs.
+// Runs with the same index use the same seed in every configuration, so results are paired.
+// Optimizers must implement OptimizerWithSeedInterface.
+type Comparison struct {
+	names        []string
+	optimizers   []OptimizerInterface
+	constructors []OptimizerConstructor
+	iterations   int
+	parallelism  int
+	seed         int64
+	target       float64
+	hasTarget    bool
+}
+
+func NewComparison() *Comparison {
+	comparison := new(Comparison)
+
+	comparison.iterations = 30
+	comparison.parallelism = 1
+	comparison.seed = 1
+
+	return comparison
+}
+
+// Configuration which runs are made by one optimizer one after another
+func (comparison *Comparison) Configuration(name string, optimizer OptimizerInterface) *Comparison {
+	comparison.names = append(comparison.names, name)
+	comparison.optimizers = append(comparison.optimizers, optimizer)
+	comparison.constructors = append(comparison.constructors, nil)
+	return comparison
+}
+
+// Configuration which creates new optimizer for each run, see OptimizerAggregator.OptimizerConstructor
+func (comparison *Comparison) ConfigurationConstructor(name string, constr OptimizerConstructor) *Comparison {
+	comparison.names = append(comparison.names, name)
+	comparison.optimizers = append(comparison.optimizers, nil)
+	comparison.constructors = append(comparison.constructors, constr)
+	return comparison
+}
+
+// Number of runs of each configuration. Default is 30.
+func (comparison *Comparison) Iterations(iterations int) *Comparison {
+	comparison.iterations = iterations
+	return comparison
+}
+
+// Number of runs of each configuration made concurrently. By default 1.
+// Values greater than 1 require all configurations to be set by ConfigurationConstructor,
+// see OptimizerAggregator.Parallelism.
+func (comparison *Comparison) Parallelism(parallelism int) *Comparison {
+	comparison.parallelism = parallelism
+	return comparison
+}
+
+// Seed of the generator of runs' seeds
+func (comparison *Comparison) Seed(seed int64) *Comparison {
+	comparison.seed = seed
+	return comparison
+}
+
+// Run is successful when its min cost reaches the target.
+// Enables success rates and evaluations to target, the latter requires optimizers
+// which implement OptimizerWithStatisticsOptionsSetup.
+func (comparison *Comparison) Target(cost float64) *Comparison {
+	comparison.target = cost
+	comparison.hasTarget = true
+	return comparison
+}
+
+func (comparison *Comparison) check() error {
+	if len(comparison.optimizers) < 2 {
+		return newConfigError("Comparison", "At least two configurations must be set")
+	}
+	if comparison.iterations <= 0 {
+		return newConfigError("Comparison", "Iterations must be positive value")
+	}
+	if comparison.parallelism <= 0 {
+		return newConfigError("Comparison", "Parallelism must be positive value")
+	}
+
+	names := make(map[string]bool, len(comparison.names))
+	for i, name := range comparison.names {
+		if names[name] {
+			return newConfigError("Comparison", "Duplicate configuration name %q", name)
+		}
+		names[name] = true
+
+		if comparison.constructors[i] != nil {
+			continue
+		}
+		if comparison.parallelism > 1 {
+			return newConfigError("Comparison", "Parallel runs of %q require ConfigurationConstructor", name)
+		}
+		if _, ok := comparison.optimizers[i].(OptimizerWithSeedInterface); !ok {
+			return newConfigError("Comparison", "Optimizer of %q must implement OptimizerWithSeedInterface", name)
+		}
+	}
+	return nil
+}
+
+// Panics on configuration errors, use CompareContext to get them as errors
+func (comparison *Comparison) Compare() *ComparisonResult {
+	result, err := comparison.CompareContext(context.Background())
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+// Context is passed to optimizers, result isn't returned when the context is done
+func (comparison *Comparison) CompareContext(ctx context.Context) (*ComparisonResult, error) {
+	if err := comparison.check(); err != nil {
+		return nil, err
+	}
+
+	rnd := rand.New(NewRandomSource(comparison.seed))
+	seeds := make([]int64, comparison.iterations)
+	for i := range seeds {
+		seeds[i] = rnd.Int63()
+	}
+
+	result := new(ComparisonResult)
+	result.target = comparison.target
+	result.hasTarget = comparison.hasTarget
+
+	for i, name := range comparison.names {
+		aggregator := NewOptimizerAggregator()
+		if comparison.constructors[i] != nil {
+			aggregator.OptimizerConstructor(comparison.constructors[i])
+		} else {
+			aggregator.Optimizer(comparison.optimizers[i])
+		}
+
+		_, data, err := aggregator.
+			StatisticsOptions(NewStatisticsDefaultOptions().
+				TrackMinCosts().
+				TrackEvaluationsCounts()).
+			Iterations(comparison.iterations).
+			Parallelism(comparison.parallelism).
+			Seeds(seeds...).
+			OptimizeContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Configurations = append(result.Configurations,
+			newConfigurationResult(name, data.(StatisticsDataAggregated), comparison.target, comparison.hasTarget))
+	}
+
+	result.rank()
+	return result, nil
+}
+
+// Results of comparison
+type ComparisonResult struct {
+	// Ordered from the lowest median final cost
+	Configurations []*ConfigurationResult
+	// Each pair of configurations, the first one is ranked higher
+	Pairs []*PairComparison
+
+	target    float64
+	hasTarget bool
+}
+
+// Results of one configuration
+type ConfigurationResult struct {
+	Name string
+	// Starts from 1
+	Rank int
+	Data StatisticsDataAggregated
+
+	// Final min cost of each run in order of runs
+	FinalCosts    []float64
+	Median        float64
+	LowerQuartile float64
+	UpperQuartile float64
+	Mean          float64
+
+	// NaN if target isn't set
+	SuccessRate float64
+	// NaN if target isn't set or no run reached it
+	MeanEvaluationsToTarget float64
+}
+
+func newConfigurationResult(name string, data StatisticsDataAggregated, target float64, hasTarget bool) *ConfigurationResult {
+	result := new(ConfigurationResult)
+
+	result.Name = name
+	result.Data = data
+
+	for _, run := range data.RunsStatistics() {
+		result.FinalCosts = append(result.FinalCosts, run.MinCost())
+	}
+	result.Median = data.FinalMinCostQuantile(0.5)
+	result.LowerQuartile = data.FinalMinCostQuantile(0.25)
+	result.UpperQuartile = data.FinalMinCostQuantile(0.75)
+	result.Mean = meanFloat64(result.FinalCosts)
+
+	result.SuccessRate = math.NaN()
+	result.MeanEvaluationsToTarget = math.NaN()
+	if hasTarget {
+		result.SuccessRate = data.SuccessRate(target)
+		result.MeanEvaluationsToTarget = data.MeanEvaluationsToTarget(target)
+	}
+
+	return result
+}
+
+// Statistical comparison of final costs of two configurations
+type PairComparison struct {
+	First  *ConfigurationResult
+	Second *ConfigurationResult
+
+	// U of the first configuration and p-value of Mann-Whitney test
+	MannWhitneyU float64
+	MannWhitneyP float64
+
+	// Sum of ranks of positive differences and p-value of Wilcoxon signed-rank test of paired runs
+	WilcoxonW float64
+	WilcoxonP float64
+
+	// Vargha-Delaney A: probability that a run of the first configuration ends with lower cost than a run of the second
+	EffectSize float64
+}
+
+func newPairComparison(first, second *ConfigurationResult) *PairComparison {
+	pair := &PairComparison{First: first, Second: second}
+
+	pair.MannWhitneyU, pair.MannWhitneyP = MannWhitneyU(first.FinalCosts, second.FinalCosts)
+	pair.WilcoxonW, pair.WilcoxonP = WilcoxonSignedRank(first.FinalCosts, second.FinalCosts)
+	pair.EffectSize = VarghaDelaneyA(second.FinalCosts, first.FinalCosts)
+
+	return pair
+}
+
+// Conventional magnitude of Vargha-Delaney effect size: negligible, small, medium or large
+func (pair *PairComparison) EffectMagnitude() string {
+	a := math.Max(pair.EffectSize, 1-pair.EffectSize)
+	switch {
+	case a < 0.56:
+		return "negligible"
+	case a < 0.64:
+		return "small"
+	case a < 0.71:
+		return "medium"
+	default:
+		return "large"
+	}
+}
+
+func (result *ComparisonResult) rank() {
+	sort.SliceStable(result.Configurations, func(i, j int) bool {
+		a, b := result.Configurations[i], result.Configurations[j]
+		if a.Median != b.Median {
+			return a.Median < b.Median
+		}
+		return a.Mean < b.Mean
+	})
+
+	for i, configuration := range result.Configurations {
+		configuration.Rank = i + 1
+	}
+
+	result.Pairs = nil
+	for i, first := range result.Configurations {
+		for _, second := range result.Configurations[i+1:] {
+			result.Pairs = append(result.Pairs, newPairComparison(first, second))
+		}
+	}
+}
+
+// Comparison of two configurations regardless of their order, nil if there is no such pair
+func (result *ComparisonResult) Pair(name1, name2 string) *PairComparison {
+	for _, pair := range result.Pairs {
+		if pair.First.Name == name1 && pair.Second.Name == name2 ||
+			pair.First.Name == name2 && pair.Second.Name == name1 {
+			return pair
+		}
+	}
+	return nil
+}
+
+// Writes ranked table of configurations.
+// Tests and effect size of each configuration are against the best one.
+func (result *ComparisonResult) WriteTable(w io.Writer) error {
+	writer := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprint(writer, "Rank\tConfiguration\tMedian\tIQR\tMean")
+	if result.hasTarget {
+		fmt.Fprint(writer, "\tSuccess\tEvals to target")
+	}
+	fmt.Fprintln(writer, "\tp (Mann-Whitney)\tp (Wilcoxon)\tA vs best")
+
+	for _, configuration := range result.Configurations {
+		fmt.Fprintf(writer, "%d\t%s\t%.6g\t%.6g..%.6g\t%.6g",
+			configuration.Rank, configuration.Name, configuration.Median,
+			configuration.LowerQuartile, configuration.UpperQuartile, configuration.Mean)
+		if result.hasTarget {
+			fmt.Fprintf(writer, "\t%.0f%%\t%.6g", configuration.SuccessRate*100, configuration.MeanEvaluationsToTarget)
+		}
+
+		if configuration.Rank == 1 {
+			fmt.Fprintln(writer, "\t-\t-\t-")
+			continue
+		}
+
+		pair := result.Pair(result.Configurations[0].Name, configuration.Name)
+		fmt.Fprintf(writer, "\t%.4g\t%.4g\t%.3f (%s)\n",
+			pair.MannWhitneyP, pair.WilcoxonP, pair.EffectSize, pair.EffectMagnitude())
+	}
+
+	return writer.Flush()
+}
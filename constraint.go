@@ -0,0 +1,311 @@
+package genetic_algorithm
+
+import (
+	"math"
+)
+
+// Cost of constrained problem.
+// Violation is total violation of constraints, solution is feasible when it equals 0.
+type ConstrainedCost struct {
+	Objective float64
+	Violation float64
+}
+
+func (cost ConstrainedCost) Feasible() bool {
+	return cost.Violation <= 0
+}
+
+// Feasible cost is better than infeasible one, feasible costs are compared by objective, infeasible ones by violation.
+// Unlike costs set by handlers, the comparison doesn't depend on generation.
+func (cost ConstrainedCost) Better(other ConstrainedCost) bool {
+	if cost.Feasible() != other.Feasible() {
+		return cost.Feasible()
+	}
+	if cost.Feasible() {
+		return cost.Objective < other.Objective
+	}
+	return cost.Violation < other.Violation
+}
+
+type ConstrainedCostFunction func(ChromosomeInterface) ConstrainedCost
+
+// Strategy of comparing solutions of constrained problem.
+// Optimizer sorts population and selectors choose parents by the cost set by the handler.
+type ConstraintHandlerInterface interface {
+	// Sets cost of each chromosome from its objective and violation, lower cost is better.
+	// costs[i] belongs to population[i].
+	Rank(population Chromosomes, costs []ConstrainedCost)
+}
+
+// Handlers which costs are comparable only within one generation, e.g. positions in ranking.
+// Stop criteria which compare costs of different generations can't be used with them.
+type GenerationRelativeCostsInterface interface {
+	GenerationRelativeCosts() bool
+}
+
+func generationRelativeCosts(handler ConstraintHandlerInterface) bool {
+	relative, ok := handler.(GenerationRelativeCostsInterface)
+	return ok && relative.GenerationRelativeCosts()
+}
+
+// Cost is objective + coefficient * violation
+type StaticPenalty struct {
+	configChecker
+
+	coefficient float64
+}
+
+func NewStaticPenalty(coefficient float64) *StaticPenalty {
+	handler := new(StaticPenalty)
+
+	if coefficient < 0 {
+		handler.invalid("StaticPenalty", "Coefficient can't be negative")
+	}
+
+	handler.coefficient = coefficient
+
+	return handler
+}
+func (handler *StaticPenalty) Rank(population Chromosomes, costs []ConstrainedCost) {
+	for i, chrom := range population {
+		chrom.SetCost(costs[i].Objective + handler.coefficient*costs[i].Violation)
+	}
+}
+
+// Penalty which coefficient depends on the history of the best solution.
+// If the best solution was feasible for the last generations the coefficient is decreased,
+// if it was infeasible the coefficient is increased.
+//
+// Source: A dual genetic algorithm for bounded integer programs. Bean J.C., Hadj-Alouane A.B. (1993)
+type AdaptivePenalty struct {
+	configChecker
+	logging
+
+	initial     float64
+	generations int
+	decrease    float64
+	increase    float64
+
+	coefficient  float64
+	bestFeasible bool
+	ranked       bool
+	history      []bool
+}
+
+// Coefficient starts from initial value and changes when the best solution
+// was (in)feasible for the given number of generations.
+// By default coefficient is divided by 2 or multiplied by 3.
+func NewAdaptivePenalty(initial float64, generations int) *AdaptivePenalty {
+	handler := new(AdaptivePenalty)
+
+	if initial <= 0 {
+		handler.invalid("AdaptivePenalty", "Initial coefficient must be positive value")
+	}
+	if generations < 1 {
+		handler.invalid("AdaptivePenalty", "Generations must be positive value")
+	}
+
+	handler.initial = initial
+	handler.coefficient = initial
+	handler.generations = generations
+	handler.decrease = 2
+	handler.increase = 3
+
+	return handler
+}
+
+// Both factors must be greater than 1 and different
+func (handler *AdaptivePenalty) Factors(decrease, increase float64) *AdaptivePenalty {
+	if decrease <= 1 || increase <= 1 || decrease == increase {
+		handler.invalid("AdaptivePenalty", "Factors must be greater than 1 and different")
+		return handler
+	}
+
+	handler.decrease = decrease
+	handler.increase = increase
+	return handler
+}
+
+// Current coefficient
+func (handler *AdaptivePenalty) Coefficient() float64 {
+	return handler.coefficient
+}
+
+// Adapts coefficient to the best solution of the finished generation.
+// Coefficient is reset at the start of optimization.
+func (handler *AdaptivePenalty) SetGeneration(generation int) {
+	if generation == 0 {
+		handler.coefficient = handler.initial
+		handler.history = handler.history[:0]
+	}
+	if !handler.ranked {
+		return
+	}
+	handler.ranked = false
+
+	handler.history = append(handler.history, handler.bestFeasible)
+	if len(handler.history) > handler.generations {
+		handler.history = handler.history[1:]
+	}
+	if len(handler.history) < handler.generations {
+		return
+	}
+
+	allFeasible, allInfeasible := true, true
+	for _, feasible := range handler.history {
+		allFeasible = allFeasible && feasible
+		allInfeasible = allInfeasible && !feasible
+	}
+
+	if allFeasible {
+		handler.coefficient /= handler.decrease
+	} else if allInfeasible {
+		handler.coefficient *= handler.increase
+	}
+	if handler.logEnabled(LogLevelDebug) {
+		handler.debugf("Penalty coefficient %v", handler.coefficient)
+	}
+}
+func (handler *AdaptivePenalty) Rank(population Chromosomes, costs []ConstrainedCost) {
+	best := -1
+	for i, chrom := range population {
+		chrom.SetCost(costs[i].Objective + handler.coefficient*costs[i].Violation)
+
+		if best == -1 || chrom.Cost() < population[best].Cost() {
+			best = i
+		}
+	}
+
+	if best != -1 {
+		handler.bestFeasible = costs[best].Feasible()
+		handler.ranked = true
+	}
+}
+
+// Coefficient changes between generations
+func (handler *AdaptivePenalty) GenerationRelativeCosts() bool {
+	return true
+}
+
+type adaptivePenaltyCheckpoint struct {
+	Coefficient  float64
+	BestFeasible bool
+	Ranked       bool
+	History      []bool
+}
+
+// Saves coefficient and feasibility of the best solutions of the last generations
+func (handler *AdaptivePenalty) MarshalCheckpoint() ([]byte, error) {
+	return encodeCheckpoint(adaptivePenaltyCheckpoint{
+		handler.coefficient,
+		handler.bestFeasible,
+		handler.ranked,
+		handler.history,
+	})
+}
+func (handler *AdaptivePenalty) UnmarshalCheckpoint(data []byte) error {
+	var checkpoint adaptivePenaltyCheckpoint
+	if err := decodeCheckpoint(data, &checkpoint); err != nil {
+		return err
+	}
+
+	handler.coefficient = checkpoint.Coefficient
+	handler.bestFeasible = checkpoint.BestFeasible
+	handler.ranked = checkpoint.Ranked
+	handler.history = checkpoint.History
+	return nil
+}
+
+// Deb's feasibility rules: feasible solution is better than infeasible one,
+// feasible solutions are compared by objective, infeasible ones by violation.
+// Cost of infeasible solution is the worst objective of feasible ones plus violation.
+// Min cost of generation with feasible solutions is the best objective, so such costs are comparable
+// between generations. Generations without feasible solutions aren't compared with a target cost,
+// see StopCriterionDefault.Min_Cost.
+//
+// Source: An efficient constraint handling method for genetic algorithms. Deb K. (2000)
+type FeasibilityRules struct{}
+
+func NewFeasibilityRules() *FeasibilityRules {
+	return new(FeasibilityRules)
+}
+func (handler *FeasibilityRules) Rank(population Chromosomes, costs []ConstrainedCost) {
+	worst := math.Inf(-1)
+	for _, cost := range costs {
+		if cost.Feasible() && cost.Objective > worst {
+			worst = cost.Objective
+		}
+	}
+	if math.IsInf(worst, -1) {
+		worst = 0
+	}
+
+	for i, chrom := range population {
+		if costs[i].Feasible() {
+			chrom.SetCost(costs[i].Objective)
+		} else {
+			chrom.SetCost(worst + costs[i].Violation)
+		}
+	}
+}
+
+// Stochastic ranking orders population by bubble sort that compares adjacent solutions
+// by objective with given probability or when both are feasible, and by violation otherwise.
+// Cost is the position of chromosome in that order.
+//
+// Source: Stochastic ranking for constrained evolutionary optimization. Runarsson T.P., Yao X. (2000)
+type StochasticRanking struct {
+	randomizer
+	configChecker
+
+	probability float64
+}
+
+// Probability of comparing by objective, usually slightly less than 0.5
+func NewStochasticRanking(probability float64) *StochasticRanking {
+	handler := new(StochasticRanking)
+
+	if probability < 0 || probability > 1 {
+		handler.invalid("StochasticRanking", "Probability must be in [0, 1] range")
+	}
+
+	handler.probability = probability
+
+	return handler
+}
+func (handler *StochasticRanking) Rank(population Chromosomes, costs []ConstrainedCost) {
+	order := make([]int, len(population))
+	for i := range order {
+		order[i] = i
+	}
+
+	for sweep := 0; sweep < len(order); sweep++ {
+		swapped := false
+		for j := 0; j < len(order)-1; j++ {
+			a, b := costs[order[j]], costs[order[j+1]]
+
+			var swap bool
+			if a.Feasible() && b.Feasible() || handler.randFloat64() < handler.probability {
+				swap = a.Objective > b.Objective
+			} else {
+				swap = a.Violation > b.Violation
+			}
+
+			if swap {
+				order[j], order[j+1] = order[j+1], order[j]
+				swapped = true
+			}
+		}
+
+		if !swapped {
+			break
+		}
+	}
+
+	for position, ind := range order {
+		population[ind].SetCost(float64(position))
+	}
+}
+func (handler *StochasticRanking) GenerationRelativeCosts() bool {
+	return true
+}
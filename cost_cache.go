@@ -0,0 +1,204 @@
+package genetic_algorithm
+
+import (
+	"container/list"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"reflect"
+	"sync"
+)
+
+// Genes that can produce hash for the cost cache.
+// Equal genes must produce equal hashes.
+// Genes that don't implement this interface are hashed by their string representation of Get values.
+type HashableGenesInterface interface {
+	Hash() uint64
+}
+
+// Hash function used by the cost cache
+type GenesHashFunction func(GenesInterface) uint64
+
+// Caches costs of already evaluated genotypes.
+// Least recently used values are evicted when capacity is exceeded.
+// Genes are kept along with costs, so genotypes with equal hashes aren't confused.
+// Safe for concurrent use.
+type CostCache struct {
+	cost     CostFunction
+	hash     GenesHashFunction
+	capacity int
+
+	mutex sync.Mutex
+	items map[uint64]*list.Element
+	order *list.List
+
+	hits   int
+	misses int
+}
+
+type costCacheItem struct {
+	hash  uint64
+	genes interface{}
+	cost  float64
+}
+
+func NewCostCache(cost CostFunction, capacity int) *CostCache {
+	if cost == nil {
+		panic(newConfigError("CostCache", "Cost must be set"))
+	}
+	if capacity <= 0 {
+		panic(newConfigError("CostCache", "Capacity must be positive value"))
+	}
+
+	cache := new(CostCache)
+
+	cache.cost = cost
+	cache.hash = HashGenes
+	cache.capacity = capacity
+	cache.items = make(map[uint64]*list.Element, capacity)
+	cache.order = list.New()
+
+	return cache
+}
+
+// Overrides hash function for all genes
+func (cache *CostCache) HashFunction(hash GenesHashFunction) *CostCache {
+	if hash == nil {
+		panic(newConfigError("CostCache", "Hash must be set"))
+	}
+
+	cache.hash = hash
+	return cache
+}
+
+// Cost function backed by the cache
+func (cache *CostCache) Cost(chrom ChromosomeInterface) float64 {
+	hash := cache.hash(chrom.Genes())
+	genes := genesValues(chrom.Genes())
+
+	cache.mutex.Lock()
+	if element, ok := cache.items[hash]; ok && reflect.DeepEqual(element.Value.(*costCacheItem).genes, genes) {
+		cache.hits++
+		cache.order.MoveToFront(element)
+		cost := element.Value.(*costCacheItem).cost
+		cache.mutex.Unlock()
+
+		return cost
+	}
+	cache.misses++
+	cache.mutex.Unlock()
+
+	cost := cache.cost(chrom)
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if element, ok := cache.items[hash]; ok {
+		// Other genotype with the same hash is replaced
+		item := element.Value.(*costCacheItem)
+		item.genes = genes
+		item.cost = cost
+		cache.order.MoveToFront(element)
+	} else {
+		cache.items[hash] = cache.order.PushFront(&costCacheItem{hash, genes, cost})
+
+		if cache.order.Len() > cache.capacity {
+			oldest := cache.order.Back()
+			cache.order.Remove(oldest)
+			delete(cache.items, oldest.Value.(*costCacheItem).hash)
+		}
+	}
+
+	return cost
+}
+
+// Number of costs taken from the cache
+func (cache *CostCache) Hits() int {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	return cache.hits
+}
+
+// Number of costs evaluated by the wrapped function
+func (cache *CostCache) Misses() int {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	return cache.misses
+}
+
+// Number of cached costs
+func (cache *CostCache) Len() int {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	return cache.order.Len()
+}
+
+// Default hash function.
+// Uses HashableGenesInterface if genes implement it.
+func HashGenes(genes GenesInterface) uint64 {
+	if hashable, ok := genes.(HashableGenesInterface); ok {
+		return hashable.Hash()
+	}
+
+	hash := fnv.New64a()
+	for i := 0; i < genes.Len(); i++ {
+		fmt.Fprintf(hash, "%v;", genes.Get(i))
+	}
+	return hash.Sum64()
+}
+
+// Copy of genes values to compare genotypes with equal hashes
+func genesValues(genes GenesInterface) interface{} {
+	switch g := genes.(type) {
+	case BinaryGenes:
+		return append(BinaryGenes(nil), g...)
+	case OrderedGenes:
+		return append(OrderedGenes(nil), g...)
+	case IntegerGenes:
+		return append(IntegerGenes(nil), g...)
+	case RealGenes:
+		return append(RealGenes(nil), g...)
+	}
+
+	values := make([]interface{}, genes.Len())
+	for i := range values {
+		values[i] = genes.Get(i)
+	}
+	return values
+}
+
+func hashInts(values []int) uint64 {
+	hash := fnv.New64a()
+	buf := make([]byte, 8)
+	for _, val := range values {
+		binary.LittleEndian.PutUint64(buf, uint64(val))
+		hash.Write(buf)
+	}
+	return hash.Sum64()
+}
+func hashFloats(values []float64) uint64 {
+	hash := fnv.New64a()
+	buf := make([]byte, 8)
+	for _, val := range values {
+		binary.LittleEndian.PutUint64(buf, math.Float64bits(val))
+		hash.Write(buf)
+	}
+	return hash.Sum64()
+}
+func hashBools(values []bool) uint64 {
+	hash := fnv.New64a()
+	buf := make([]byte, 1)
+	for _, val := range values {
+		if val {
+			buf[0] = 1
+		} else {
+			buf[0] = 0
+		}
+		hash.Write(buf)
+	}
+	return hash.Sum64()
+}
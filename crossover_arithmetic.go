@@ -0,0 +1,159 @@
+package genetic_algorithm
+
+const (
+	arithmeticCrossoverWhole  = 0
+	arithmeticCrossoverSimple = 1
+)
+
+// Crossover for real chromosomes.
+// Children genes are weighted sums of parents genes:
+//
+// child1 = alpha*parent1 + (1-alpha)*parent2
+// child2 = (1-alpha)*parent1 + alpha*parent2
+//
+// Whole arithmetic crossover combines all genes.
+// Simple arithmetic crossover chooses cross point, genes before it are copied from parents,
+// genes after it are combined.
+//
+// By default alpha is randomly chosen from [0:1] for every crossover.
+type ArithmeticCrossover struct {
+	randomizer
+	configChecker
+	logging
+
+	kind                      int
+	alpha                     float64
+	randomAlpha               bool
+	canProduceCopiesOfParents bool
+}
+
+func NewWholeArithmeticCrossover() *ArithmeticCrossover {
+	return newArithmeticCrossover(arithmeticCrossoverWhole)
+}
+func NewSimpleArithmeticCrossover() *ArithmeticCrossover {
+	return newArithmeticCrossover(arithmeticCrossoverSimple)
+}
+func newArithmeticCrossover(kind int) *ArithmeticCrossover {
+	crossover := new(ArithmeticCrossover)
+
+	crossover.kind = kind
+	crossover.randomAlpha = true
+
+	return crossover
+}
+
+func (crossover *ArithmeticCrossover) ParentsCount() int {
+	return 2
+}
+
+// Sets fixed weight of parents. Alpha=0.5 produces two identical children - mean of the parents.
+func (crossover *ArithmeticCrossover) Alpha(alpha float64) *ArithmeticCrossover {
+	if alpha < 0 || alpha > 1 {
+		crossover.invalid("ArithmeticCrossover", "Incorrect alpha %v", alpha)
+		return crossover
+	}
+
+	crossover.alpha = alpha
+	crossover.randomAlpha = false
+	return crossover
+}
+
+// Alpha will be randomly chosen from [0:1] for every crossover
+func (crossover *ArithmeticCrossover) RandomAlpha() *ArithmeticCrossover {
+	crossover.randomAlpha = true
+	return crossover
+}
+
+// When false alpha can't be equal to 0 or 1 and simple crossover will combine at least one gene
+func (crossover *ArithmeticCrossover) CanProduceCopiesOfParents(val bool) *ArithmeticCrossover {
+	crossover.canProduceCopiesOfParents = val
+	return crossover
+}
+func (crossover *ArithmeticCrossover) Check() error {
+	if crossover.configErr != nil {
+		return crossover.configErr
+	}
+	if !crossover.randomAlpha && !crossover.canProduceCopiesOfParents && (crossover.alpha == 0 || crossover.alpha == 1) {
+		return newConfigError("ArithmeticCrossover", "Crossover can only produce copies of parents with alpha equals 0 or 1")
+	}
+	return nil
+}
+
+func (crossover *ArithmeticCrossover) Crossover(parents Chromosomes) Chromosomes {
+	if len(parents) != crossover.ParentsCount() {
+		panic(newConfigError("ArithmeticCrossover", "Expected %d parents, got %d", crossover.ParentsCount(), len(parents)))
+	}
+
+	p1, ok := parents[0].(*RealChromosome)
+	if !ok {
+		panic(newConfigError("ArithmeticCrossover", "Expected RealChromosome, got %T", parents[0]))
+	}
+	p2, ok := parents[1].(*RealChromosome)
+	if !ok {
+		panic(newConfigError("ArithmeticCrossover", "Expected RealChromosome, got %T", parents[1]))
+	}
+
+	genesLen := p1.Genes().Len()
+
+	if genesLen != p2.Genes().Len() {
+		panic(newConfigError("ArithmeticCrossover", "Crossover do not support different chromosome size"))
+	}
+
+	alpha := crossover.chooseAlpha()
+	crossPoint := 0
+	if crossover.kind == arithmeticCrossoverSimple {
+		crossPoint = crossover.chooseCrossPoint(genesLen)
+	}
+
+	if crossover.logEnabled(LogLevelTrace) {
+		crossover.tracef("Cross with alpha %v from %d", alpha, crossPoint)
+	}
+
+	c1, c2 := crossover.crossover(p1, p2, alpha, crossPoint)
+
+	return Chromosomes{c1, c2}
+}
+func (crossover *ArithmeticCrossover) chooseAlpha() float64 {
+	if !crossover.randomAlpha {
+		if err := crossover.Check(); err != nil {
+			panic(err)
+		}
+		return crossover.alpha
+	}
+
+	for {
+		alpha := crossover.randFloat64()
+		if crossover.canProduceCopiesOfParents || alpha != 0 {
+			return alpha
+		}
+	}
+}
+func (crossover *ArithmeticCrossover) chooseCrossPoint(genesLen int) int {
+	if crossover.canProduceCopiesOfParents {
+		return crossover.randIntn(genesLen + 1)
+	}
+	return crossover.randIntn(genesLen)
+}
+func (crossover *ArithmeticCrossover) crossover(p1, p2 *RealChromosome, alpha float64, crossPoint int) (c1, c2 *RealChromosome) {
+	p1genes := p1.RealGenes()
+	p2genes := p2.RealGenes()
+	bounds := p1.Bounds()
+
+	genesLen := p1genes.Len()
+
+	c1 = NewRealChromosome(make(RealGenes, genesLen), bounds)
+	c1genes := c1.RealGenes()
+
+	c2 = NewRealChromosome(make(RealGenes, genesLen), bounds)
+	c2genes := c2.RealGenes()
+
+	copy(c1genes, p1genes[:crossPoint])
+	copy(c2genes, p2genes[:crossPoint])
+
+	for i := crossPoint; i < genesLen; i++ {
+		c1genes[i] = bounds.Clamp(i, alpha*p1genes[i]+(1-alpha)*p2genes[i])
+		c2genes[i] = bounds.Clamp(i, (1-alpha)*p1genes[i]+alpha*p2genes[i])
+	}
+
+	return
+}
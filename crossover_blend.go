@@ -0,0 +1,110 @@
+package genetic_algorithm
+
+import (
+	"math"
+)
+
+// Crossover for real chromosomes. Also known as BLX-alpha.
+// Each child gene is chosen uniformly from the interval spanned by parents genes,
+// extended on both sides by alpha times its length:
+//
+// parents:    ----x1=====x2----
+// child:   [x1-alpha*d : x2+alpha*d], d = x2-x1
+//
+// Values are clamped to the genes bounds.
+//
+// Real-Coded Genetic Algorithms and Interval-Schemata. Larry J. Eshelman, J. David Schaffer (1993)
+type BlendCrossover struct {
+	randomizer
+	configChecker
+	logging
+
+	alpha                     float64
+	canProduceCopiesOfParents bool
+}
+
+// Alpha=0.5 is the common choice
+func NewBlendCrossover(alpha float64) *BlendCrossover {
+	crossover := new(BlendCrossover)
+
+	if alpha < 0 {
+		crossover.invalid("BlendCrossover", "alpha can't be negative")
+	}
+
+	crossover.alpha = alpha
+
+	return crossover
+}
+
+func (crossover *BlendCrossover) ParentsCount() int {
+	return 2
+}
+
+// When false children that are equal to the parents will be regenerated
+func (crossover *BlendCrossover) CanProduceCopiesOfParents(val bool) *BlendCrossover {
+	crossover.canProduceCopiesOfParents = val
+	return crossover
+}
+
+func (crossover *BlendCrossover) Crossover(parents Chromosomes) Chromosomes {
+	if len(parents) != crossover.ParentsCount() {
+		panic(newConfigError("BlendCrossover", "Expected %d parents, got %d", crossover.ParentsCount(), len(parents)))
+	}
+
+	p1, ok := parents[0].(*RealChromosome)
+	if !ok {
+		panic(newConfigError("BlendCrossover", "Expected RealChromosome, got %T", parents[0]))
+	}
+	p2, ok := parents[1].(*RealChromosome)
+	if !ok {
+		panic(newConfigError("BlendCrossover", "Expected RealChromosome, got %T", parents[1]))
+	}
+
+	genesLen := p1.Genes().Len()
+
+	if genesLen != p2.Genes().Len() {
+		panic(newConfigError("BlendCrossover", "Crossover do not support different chromosome size"))
+	}
+
+	c1 := crossover.child(p1, p2)
+	c2 := crossover.child(p1, p2)
+
+	return Chromosomes{c1, c2}
+}
+func (crossover *BlendCrossover) child(p1, p2 *RealChromosome) *RealChromosome {
+	p1genes := p1.RealGenes()
+	p2genes := p2.RealGenes()
+	bounds := p1.Bounds()
+
+	genesLen := p1genes.Len()
+
+	c := NewRealChromosome(make(RealGenes, genesLen), bounds)
+	cgenes := c.RealGenes()
+
+	for {
+		for i := 0; i < genesLen; i++ {
+			x1 := math.Min(p1genes[i], p2genes[i])
+			x2 := math.Max(p1genes[i], p2genes[i])
+			d := crossover.alpha * (x2 - x1)
+
+			cgenes[i] = bounds.Clamp(i, x1-d+crossover.randFloat64()*(x2-x1+2*d))
+		}
+
+		if crossover.canProduceCopiesOfParents || !crossover.isCopy(cgenes, p1genes, p2genes) {
+			break
+		}
+
+		crossover.tracef("Child is a copy of parent. Regenerating")
+	}
+
+	return c
+}
+
+// Returns false for identical parents as their copies can't be avoided
+func (crossover *BlendCrossover) isCopy(c, p1, p2 RealGenes) bool {
+	if realGenesEqual(p1, p2) {
+		return false
+	}
+
+	return realGenesEqual(c, p1) || realGenesEqual(c, p2)
+}
@@ -1,12 +1,9 @@
 package genetic_algorithm
 
-import (
-	log "github.com/cihub/seelog"
-)
-
 // Crossover for ordered chromosomes.
 // Tends to preserve absolute order.
 type CycleCrossover struct {
+	logging
 }
 
 func NewCycleCrossover() *CycleCrossover {
@@ -21,27 +18,29 @@ func (crossover *CycleCrossover) ParentsCount() int {
 
 func (crossover *CycleCrossover) Crossover(parents Chromosomes) Chromosomes {
 	if len(parents) != crossover.ParentsCount() {
-		panic("Incorrect parents count")
+		panic(newConfigError("CycleCrossover", "Expected %d parents, got %d", crossover.ParentsCount(), len(parents)))
 	}
 
 	p1, ok := parents[0].(*OrderedChromosome)
 	if !ok {
-		panic("Expects OrderedChromosome")
+		panic(newConfigError("CycleCrossover", "Expected OrderedChromosome, got %T", parents[0]))
 	}
 	p2, ok := parents[1].(*OrderedChromosome)
 	if !ok {
-		panic("Expects OrderedChromosome")
+		panic(newConfigError("CycleCrossover", "Expected OrderedChromosome, got %T", parents[1]))
 	}
 
 	genesLen := p1.Genes().Len()
 
 	if genesLen != p2.Genes().Len() {
-		panic("Crossover do not support different chromosome size")
+		panic(newConfigError("CycleCrossover", "Crossover do not support different chromosome size"))
 	}
 
 	mask := crossover.generateMask(p1, p2)
 
-	log.Tracef("Cross with %v", mask)
+	if crossover.logEnabled(LogLevelTrace) {
+		crossover.tracef("Cross with %v", mask)
+	}
 
 	c1, c2 := crossover.crossover(p1, p2, mask)
 
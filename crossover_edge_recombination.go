@@ -1,14 +1,15 @@
 package genetic_algorithm
 
 import (
-	log "github.com/cihub/seelog"
-	"math/rand"
+	"sort"
 )
 
 // The edge recombination operator (ERO) is an operator that creates a path that is similar to a set of existing paths (parents) by looking at the edges rather than the vertices.
 //
 // http://en.wikipedia.org/wiki/Edge_recombination_operator
 type EdgeRecombinationCrossover struct {
+	randomizer
+	logging
 }
 
 func NewEdgeRecombinationCrossover() *EdgeRecombinationCrossover {
@@ -23,27 +24,29 @@ func (crossover *EdgeRecombinationCrossover) ParentsCount() int {
 
 func (crossover *EdgeRecombinationCrossover) Crossover(parents Chromosomes) Chromosomes {
 	if len(parents) != crossover.ParentsCount() {
-		panic("Incorrect parents count")
+		panic(newConfigError("EdgeRecombinationCrossover", "Expected %d parents, got %d", crossover.ParentsCount(), len(parents)))
 	}
 
 	p1, ok := parents[0].(*OrderedChromosome)
 	if !ok {
-		panic("Expects OrderedChromosome")
+		panic(newConfigError("EdgeRecombinationCrossover", "Expected OrderedChromosome, got %T", parents[0]))
 	}
 	p2, ok := parents[1].(*OrderedChromosome)
 	if !ok {
-		panic("Expects OrderedChromosome")
+		panic(newConfigError("EdgeRecombinationCrossover", "Expected OrderedChromosome, got %T", parents[1]))
 	}
 
 	genesLen := p1.Genes().Len()
 
 	if genesLen != p2.Genes().Len() {
-		panic("Crossover do not support different chromosome size")
+		panic(newConfigError("EdgeRecombinationCrossover", "Crossover do not support different chromosome size"))
 	}
 
 	matrix := crossover.generateMatrix(p1, p2)
 
-	log.Tracef("Cross with %v", matrix)
+	if crossover.logEnabled(LogLevelTrace) {
+		crossover.tracef("Cross with %v", matrix)
+	}
 
 	c1 := crossover.crossover(p1, p2, matrix)
 
@@ -90,7 +93,7 @@ func (crossover *EdgeRecombinationCrossover) fillChild(c, p1, p2 OrderedGenes, m
 	nextNs := make([]int, 0, 4)
 
 	var n int
-	if rand.Intn(2) == 0 {
+	if crossover.randIntn(2) == 0 {
 		n = p1[0]
 	} else {
 		n = p2[0]
@@ -120,10 +123,12 @@ func (crossover *EdgeRecombinationCrossover) fillChild(c, p1, p2 OrderedGenes, m
 				break
 			}
 			for k, _ := range matrix {
-				n = k
+				nextNs = append(nextNs, k)
 			}
-		} else {
-			n = nextNs[rand.Intn(len(nextNs))]
 		}
+
+		// Map iteration order is random, sort to keep the result determined by the generator
+		sort.Ints(nextNs)
+		n = nextNs[crossover.randIntn(len(nextNs))]
 	}
 }
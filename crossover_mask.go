@@ -0,0 +1,123 @@
+package genetic_algorithm
+
+// Produces mask for MaskCrossover. Must return slice of genesLen length.
+type CrossoverMaskFunction func(genesLen int) []bool
+
+// Crossover for chromosomes of any kind.
+// Genes marked in the mask are swapped between children:
+//
+// parent1:  A B C D E F
+// parent2:  a b c d e f
+// mask:     _ * * _ _ *
+//
+// child1:   A b c D E f
+// child2:   a B C d e F
+//
+// When the mask would produce copies of parents and it is not allowed
+// one random position of the mask will be flipped.
+type MaskCrossover struct {
+	randomizer
+	configChecker
+	logging
+
+	chromConstr               EmptyChromosomeConstructor
+	maskFunction              CrossoverMaskFunction
+	canProduceCopiesOfParents bool
+}
+
+func NewMaskCrossover(chromConstr EmptyChromosomeConstructor, maskFunction CrossoverMaskFunction) *MaskCrossover {
+	crossover := new(MaskCrossover)
+
+	if maskFunction == nil {
+		crossover.invalid("MaskCrossover", "maskFunction must be set")
+	}
+
+	crossover.chromConstr = chromConstr
+	crossover.maskFunction = maskFunction
+
+	return crossover
+}
+
+func (crossover *MaskCrossover) ParentsCount() int {
+	return 2
+}
+func (crossover *MaskCrossover) CanProduceCopiesOfParents(val bool) *MaskCrossover {
+	crossover.canProduceCopiesOfParents = val
+	return crossover
+}
+func (crossover *MaskCrossover) Crossover(parents Chromosomes) Chromosomes {
+	if len(parents) != crossover.ParentsCount() {
+		panic(newConfigError("MaskCrossover", "Expected %d parents, got %d", crossover.ParentsCount(), len(parents)))
+	}
+
+	p1 := parents[0]
+	p2 := parents[1]
+
+	genesLen := p1.Genes().Len()
+
+	if genesLen != p2.Genes().Len() {
+		panic(newConfigError("MaskCrossover", "Crossover do not support different chromosome size"))
+	}
+
+	if !crossover.canProduceCopiesOfParents && genesLen < 2 {
+		panic(newConfigError("MaskCrossover", "Crossover can only produce copies of parents if genesLen < 2"))
+	}
+
+	mask := crossover.generateMask(genesLen)
+
+	if crossover.logEnabled(LogLevelTrace) {
+		crossover.tracef("Cross with %v", mask)
+	}
+
+	c1, c2 := crossover.crossover(p1, p2, mask)
+
+	return Chromosomes{c1, c2}
+}
+func (crossover *MaskCrossover) generateMask(genesLen int) []bool {
+	mask := crossover.maskFunction(genesLen)
+	if len(mask) != genesLen {
+		panic(newConfigError("MaskCrossover", "Mask length %d differs from chromosome length %d", len(mask), genesLen))
+	}
+
+	if crossover.canProduceCopiesOfParents {
+		return mask
+	}
+
+	swapped := 0
+	for _, swap := range mask {
+		if swap {
+			swapped++
+		}
+	}
+
+	if swapped == 0 || swapped == genesLen {
+		ind := crossover.randIntn(genesLen)
+		mask[ind] = !mask[ind]
+	}
+
+	return mask
+}
+func (crossover *MaskCrossover) crossover(p1, p2 ChromosomeInterface, mask []bool) (c1, c2 ChromosomeInterface) {
+	p1genes := p1.Genes()
+	p2genes := p2.Genes()
+
+	genesLen := p1genes.Len()
+
+	c1 = crossover.chromConstr(genesLen)
+	c1genes := c1.Genes()
+
+	c2 = crossover.chromConstr(genesLen)
+	c2genes := c2.Genes()
+
+	for i := 0; i < genesLen; i++ {
+		if mask[i] {
+			c1genes.Set(i, p2genes.Get(i))
+			c2genes.Set(i, p1genes.Get(i))
+		} else {
+			c1genes.Set(i, p1genes.Get(i))
+			c2genes.Set(i, p2genes.Get(i))
+		}
+	}
+
+	return
+}
@@ -1,24 +1,26 @@
 package genetic_algorithm
 
 import (
-	log "github.com/cihub/seelog"
-	"math/rand"
 	"sort"
 )
 
 type MultiPointCrossover struct {
+	randomizer
+	configChecker
+	logging
+
 	crossPointsCount          int
 	chromConstr               EmptyChromosomeConstructor
 	canProduceCopiesOfParents bool
 }
 
 func NewMultiPointCrossover(chromConstr EmptyChromosomeConstructor, crossPointsCount int) *MultiPointCrossover {
+	crossover := new(MultiPointCrossover)
+
 	if crossPointsCount <= 0 {
-		panic("crossPointsCount must be positive")
+		crossover.invalid("MultiPointCrossover", "crossPointsCount must be positive")
 	}
 
-	crossover := new(MultiPointCrossover)
-
 	crossover.chromConstr = chromConstr
 	crossover.crossPointsCount = crossPointsCount
 
@@ -40,7 +42,7 @@ func (crossover *MultiPointCrossover) CanProduceCopiesOfParents(val bool) *Multi
 }
 func (crossover *MultiPointCrossover) Crossover(parents Chromosomes) Chromosomes {
 	if len(parents) != crossover.ParentsCount() {
-		panic("Incorrect parents count")
+		panic(newConfigError("MultiPointCrossover", "Expected %d parents, got %d", crossover.ParentsCount(), len(parents)))
 	}
 
 	p1 := parents[0]
@@ -49,21 +51,25 @@ func (crossover *MultiPointCrossover) Crossover(parents Chromosomes) Chromosomes
 	genesLen := p1.Genes().Len()
 
 	if genesLen != p2.Genes().Len() {
-		panic("Crossover do not support different chromosome size")
+		panic(newConfigError("MultiPointCrossover", "Crossover do not support different chromosome size"))
 	}
 
-	crossover.checkGenesLen(genesLen)
+	if err := crossover.CheckChromSize(genesLen); err != nil {
+		panic(err)
+	}
 
 	crossPointsList := crossover.chooseCrossPoints(genesLen)
 	sort.Sort(sort.IntSlice(crossPointsList))
 
-	log.Tracef("Cross on %v\n", crossPointsList)
+	if crossover.logEnabled(LogLevelTrace) {
+		crossover.tracef("Cross on %v", crossPointsList)
+	}
 
 	c1, c2 := crossover.crossover(p1, p2, crossPointsList)
 
 	return Chromosomes{c1, c2}
 }
-func (crossover *MultiPointCrossover) checkGenesLen(genesLen int) {
+func (crossover *MultiPointCrossover) CheckChromSize(genesLen int) error {
 	possibleCrossPoints := genesLen + 1
 	if !crossover.canProduceCopiesOfParents && crossover.crossPointsCount <= 2 {
 		if crossover.crossPointsCount == 1 {
@@ -74,21 +80,22 @@ func (crossover *MultiPointCrossover) checkGenesLen(genesLen int) {
 	}
 
 	if possibleCrossPoints < crossover.crossPointsCount {
-		panic("Chromosome too short")
+		return newConfigError("MultiPointCrossover", "Chromosome of length %d is too short for %d cross points", genesLen, crossover.crossPointsCount)
 	}
+	return nil
 }
 func (crossover *MultiPointCrossover) chooseCrossPoints(genesLen int) []int {
 	if crossover.crossPointsCount == 1 {
 		if crossover.canProduceCopiesOfParents {
-			return []int{rand.Intn(genesLen + 1)}
+			return []int{crossover.randIntn(genesLen + 1)}
 		} else {
-			return []int{rand.Intn(genesLen-1) + 1}
+			return []int{crossover.randIntn(genesLen-1) + 1}
 		}
 	} else if crossover.crossPointsCount == 2 {
-		p1, p2 := chooseTwoPointCrossSection(genesLen, crossover.canProduceCopiesOfParents)
+		p1, p2 := crossover.chooseTwoPointCrossSection(genesLen, crossover.canProduceCopiesOfParents)
 		return []int{p1, p2}
 	}
-	return chooseDifferentRandomNumbers(crossover.crossPointsCount, genesLen+1)
+	return crossover.chooseDifferentRandomNumbers(crossover.crossPointsCount, genesLen+1)
 }
 func (crossover *MultiPointCrossover) crossover(p1, p2 ChromosomeInterface, crossPoints []int) (c1, c2 ChromosomeInterface) {
 	p1genes := p1.Genes()
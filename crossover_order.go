@@ -1,10 +1,9 @@
 package genetic_algorithm
 
-import (
-	log "github.com/cihub/seelog"
-)
-
 type orderCrossover struct {
+	randomizer
+	logging
+
 	virtualMethods            orderCrossoverVirtualMInterface
 	canProduceCopiesOfParents bool
 }
@@ -28,33 +27,41 @@ func (crossover *orderCrossover) CanProduceCopiesOfParents(val bool) *orderCross
 	return crossover
 }
 
+func (crossover *orderCrossover) CheckChromSize(genesLen int) error {
+	if !crossover.canProduceCopiesOfParents && genesLen < 2 {
+		return newConfigError("OrderCrossover", "Crossover can only produce copies of parents if genesLen < 2")
+	}
+	return nil
+}
 func (crossover *orderCrossover) Crossover(parents Chromosomes) Chromosomes {
 	if len(parents) != crossover.ParentsCount() {
-		panic("Incorrect parents count")
+		panic(newConfigError("OrderCrossover", "Expected %d parents, got %d", crossover.ParentsCount(), len(parents)))
 	}
 
 	p1, ok := parents[0].(*OrderedChromosome)
 	if !ok {
-		panic("Expects OrderedChromosome")
+		panic(newConfigError("OrderCrossover", "Expected OrderedChromosome, got %T", parents[0]))
 	}
 	p2, ok := parents[1].(*OrderedChromosome)
 	if !ok {
-		panic("Expects OrderedChromosome")
+		panic(newConfigError("OrderCrossover", "Expected OrderedChromosome, got %T", parents[1]))
 	}
 
 	genesLen := p1.Genes().Len()
 
 	if genesLen != p2.Genes().Len() {
-		panic("Crossover do not support different chromosome size")
+		panic(newConfigError("OrderCrossover", "Crossover do not support different chromosome size"))
 	}
 
-	if !crossover.canProduceCopiesOfParents && genesLen < 2 {
-		panic("Crossover can only produce copies of parents if genesLen < 2")
+	if err := crossover.CheckChromSize(genesLen); err != nil {
+		panic(err)
 	}
 
-	crossPoint1, crossPoint2 := chooseTwoPointCrossSection(genesLen, crossover.canProduceCopiesOfParents)
+	crossPoint1, crossPoint2 := crossover.chooseTwoPointCrossSection(genesLen, crossover.canProduceCopiesOfParents)
 
-	log.Tracef("Cross on %d:%d", crossPoint1, crossPoint2)
+	if crossover.logEnabled(LogLevelTrace) {
+		crossover.tracef("Cross on %d:%d", crossPoint1, crossPoint2)
+	}
 
 	c1, c2 := crossover.crossover(p1, p2, crossPoint1, crossPoint2)
 
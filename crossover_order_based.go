@@ -1,10 +1,5 @@
 package genetic_algorithm
 
-import (
-	log "github.com/cihub/seelog"
-	"math/rand"
-)
-
 // Crossover for ordered chromosomes.
 // Tends to preserve relative order.
 //
@@ -26,6 +21,9 @@ import (
 // Source: Modeling Simple Genetic Algorithms for Permutation Problems. Darrell Whitley , Nam-wook Yoo (1995)
 // http://citeseerx.ist.psu.edu/viewdoc/summary?doi=10.1.1.18.3585
 type OrderBasedCrossover struct {
+	randomizer
+	logging
+
 	canProduceCopiesOfParents bool
 }
 
@@ -43,33 +41,41 @@ func (crossover *OrderBasedCrossover) CanProduceCopiesOfParents(val bool) *Order
 	return crossover
 }
 
+func (crossover *OrderBasedCrossover) CheckChromSize(genesLen int) error {
+	if !crossover.canProduceCopiesOfParents && genesLen < 2 {
+		return newConfigError("OrderBasedCrossover", "Crossover can only produce copies of parents if genesLen < 2")
+	}
+	return nil
+}
 func (crossover *OrderBasedCrossover) Crossover(parents Chromosomes) Chromosomes {
 	if len(parents) != crossover.ParentsCount() {
-		panic("Incorrect parents count")
+		panic(newConfigError("OrderBasedCrossover", "Expected %d parents, got %d", crossover.ParentsCount(), len(parents)))
 	}
 
 	p1, ok := parents[0].(*OrderedChromosome)
 	if !ok {
-		panic("Expects OrderedChromosome")
+		panic(newConfigError("OrderBasedCrossover", "Expected OrderedChromosome, got %T", parents[0]))
 	}
 	p2, ok := parents[1].(*OrderedChromosome)
 	if !ok {
-		panic("Expects OrderedChromosome")
+		panic(newConfigError("OrderBasedCrossover", "Expected OrderedChromosome, got %T", parents[1]))
 	}
 
 	genesLen := p1.Genes().Len()
 
 	if genesLen != p2.Genes().Len() {
-		panic("Crossover do not support different chromosome size")
+		panic(newConfigError("OrderBasedCrossover", "Crossover do not support different chromosome size"))
 	}
 
-	if !crossover.canProduceCopiesOfParents && genesLen < 2 {
-		panic("Crossover can only produce copies of parents if genesLen < 2")
+	if err := crossover.CheckChromSize(genesLen); err != nil {
+		panic(err)
 	}
 
 	mask := crossover.generateMask(genesLen)
 
-	log.Tracef("Cross with %v", mask)
+	if crossover.logEnabled(LogLevelTrace) {
+		crossover.tracef("Cross with %v", mask)
+	}
 
 	c1, c2 := crossover.crossover(p1, p2, mask)
 
@@ -78,7 +84,7 @@ func (crossover *OrderBasedCrossover) Crossover(parents Chromosomes) Chromosomes
 func (crossover *OrderBasedCrossover) generateMask(genesLen int) []int {
 	mask := make([]int, 0, genesLen/2)
 	for i := 0; i < genesLen; i++ {
-		if rand.Intn(2) == 0 {
+		if crossover.randIntn(2) == 0 {
 			mask = append(mask, i)
 		}
 	}
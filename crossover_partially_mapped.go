@@ -1,15 +1,14 @@
 package genetic_algorithm
 
-import (
-	log "github.com/cihub/seelog"
-)
-
 // Crossover for ordered chromosomes.
 // Tends to preserve absolute order.
 //
 // Source: Modeling Simple Genetic Algorithms for Permutation Problems. Darrell Whitley , Nam-wook Yoo (1995)
 // http://citeseerx.ist.psu.edu/viewdoc/summary?doi=10.1.1.18.3585
 type PartiallyMappedCrossover struct {
+	randomizer
+	logging
+
 	canProduceCopiesOfParents bool
 }
 
@@ -27,33 +26,41 @@ func (crossover *PartiallyMappedCrossover) CanProduceCopiesOfParents(val bool) *
 	return crossover
 }
 
+func (crossover *PartiallyMappedCrossover) CheckChromSize(genesLen int) error {
+	if !crossover.canProduceCopiesOfParents && genesLen < 2 {
+		return newConfigError("PartiallyMappedCrossover", "Crossover can only produce copies of parents if genesLen < 2")
+	}
+	return nil
+}
 func (crossover *PartiallyMappedCrossover) Crossover(parents Chromosomes) Chromosomes {
 	if len(parents) != crossover.ParentsCount() {
-		panic("Incorrect parents count")
+		panic(newConfigError("PartiallyMappedCrossover", "Expected %d parents, got %d", crossover.ParentsCount(), len(parents)))
 	}
 
 	p1, ok := parents[0].(*OrderedChromosome)
 	if !ok {
-		panic("Expects OrderedChromosome")
+		panic(newConfigError("PartiallyMappedCrossover", "Expected OrderedChromosome, got %T", parents[0]))
 	}
 	p2, ok := parents[1].(*OrderedChromosome)
 	if !ok {
-		panic("Expects OrderedChromosome")
+		panic(newConfigError("PartiallyMappedCrossover", "Expected OrderedChromosome, got %T", parents[1]))
 	}
 
 	genesLen := p1.Genes().Len()
 
 	if genesLen != p2.Genes().Len() {
-		panic("Crossover do not support different chromosome size")
+		panic(newConfigError("PartiallyMappedCrossover", "Crossover do not support different chromosome size"))
 	}
 
-	if !crossover.canProduceCopiesOfParents && genesLen < 2 {
-		panic("Crossover can only produce copies of parents if genesLen < 2")
+	if err := crossover.CheckChromSize(genesLen); err != nil {
+		panic(err)
 	}
 
-	crossPoint1, crossPoint2 := chooseTwoPointCrossSection(genesLen, crossover.canProduceCopiesOfParents)
+	crossPoint1, crossPoint2 := crossover.chooseTwoPointCrossSection(genesLen, crossover.canProduceCopiesOfParents)
 
-	log.Tracef("Cross on %d:%d", crossPoint1, crossPoint2)
+	if crossover.logEnabled(LogLevelTrace) {
+		crossover.tracef("Cross on %d:%d", crossPoint1, crossPoint2)
+	}
 
 	c1, c2 := crossover.crossover(p1, p2, crossPoint1, crossPoint2)
 
@@ -1,10 +1,5 @@
 package genetic_algorithm
 
-import (
-	log "github.com/cihub/seelog"
-	"math/rand"
-)
-
 // Crossover for ordered chromosomes.
 // Generalization of OrderCrossoverVer2.
 // Tends to preserve relative order.
@@ -25,6 +20,9 @@ import (
 // Source: Modeling Simple Genetic Algorithms for Permutation Problems. Darrell Whitley, Nam-wook Yoo (1995)
 // http://citeseerx.ist.psu.edu/viewdoc/summary?doi=10.1.1.18.3585
 type PositionBasedCrossover struct {
+	randomizer
+	logging
+
 	canProduceCopiesOfParents bool
 }
 
@@ -42,33 +40,41 @@ func (crossover *PositionBasedCrossover) CanProduceCopiesOfParents(val bool) *Po
 	return crossover
 }
 
+func (crossover *PositionBasedCrossover) CheckChromSize(genesLen int) error {
+	if !crossover.canProduceCopiesOfParents && genesLen < 2 {
+		return newConfigError("PositionBasedCrossover", "Crossover can only produce copies of parents if genesLen < 2")
+	}
+	return nil
+}
 func (crossover *PositionBasedCrossover) Crossover(parents Chromosomes) Chromosomes {
 	if len(parents) != crossover.ParentsCount() {
-		panic("Incorrect parents count")
+		panic(newConfigError("PositionBasedCrossover", "Expected %d parents, got %d", crossover.ParentsCount(), len(parents)))
 	}
 
 	p1, ok := parents[0].(*OrderedChromosome)
 	if !ok {
-		panic("Expects OrderedChromosome")
+		panic(newConfigError("PositionBasedCrossover", "Expected OrderedChromosome, got %T", parents[0]))
 	}
 	p2, ok := parents[1].(*OrderedChromosome)
 	if !ok {
-		panic("Expects OrderedChromosome")
+		panic(newConfigError("PositionBasedCrossover", "Expected OrderedChromosome, got %T", parents[1]))
 	}
 
 	genesLen := p1.Genes().Len()
 
 	if genesLen != p2.Genes().Len() {
-		panic("Crossover do not support different chromosome size")
+		panic(newConfigError("PositionBasedCrossover", "Crossover do not support different chromosome size"))
 	}
 
-	if !crossover.canProduceCopiesOfParents && genesLen < 2 {
-		panic("Crossover can only produce copies of parents if genesLen < 2")
+	if err := crossover.CheckChromSize(genesLen); err != nil {
+		panic(err)
 	}
 
 	mask := crossover.generateMask(genesLen)
 
-	log.Tracef("Cross with %v", mask)
+	if crossover.logEnabled(LogLevelTrace) {
+		crossover.tracef("Cross with %v", mask)
+	}
 
 	c1, c2 := crossover.crossover(p1, p2, mask)
 
@@ -77,7 +83,7 @@ func (crossover *PositionBasedCrossover) Crossover(parents Chromosomes) Chromoso
 func (crossover *PositionBasedCrossover) generateMask(genesLen int) []int {
 	mask := make([]int, 0, genesLen/2)
 	for i := 0; i < genesLen; i++ {
-		if rand.Intn(2) == 0 {
+		if crossover.randIntn(2) == 0 {
 			mask = append(mask, i)
 		}
 	}
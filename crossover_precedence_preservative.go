@@ -1,16 +1,13 @@
 package genetic_algorithm
 
-import (
-	log "github.com/cihub/seelog"
-	"math/rand"
-)
-
 // Crossover for ordered chromosomes.
 // Tends to preserve absolute order.
 //
 // Source: Introduction to Genetic Algorithms. S.N. Sivanandam, S. N. Deepa (2008)
 // http://www.amazon.com/Introduction-Genetic-Algorithms-S-N-Sivanandam/dp/354073189X/
 type PrecedencePreservativeCrossover struct {
+	randomizer
+	logging
 }
 
 func NewPrecedencePreservativeCrossover() *PrecedencePreservativeCrossover {
@@ -25,27 +22,29 @@ func (crossover *PrecedencePreservativeCrossover) ParentsCount() int {
 
 func (crossover *PrecedencePreservativeCrossover) Crossover(parents Chromosomes) Chromosomes {
 	if len(parents) != crossover.ParentsCount() {
-		panic("Incorrect parents count")
+		panic(newConfigError("PrecedencePreservativeCrossover", "Expected %d parents, got %d", crossover.ParentsCount(), len(parents)))
 	}
 
 	p1, ok := parents[0].(*OrderedChromosome)
 	if !ok {
-		panic("Expects OrderedChromosome")
+		panic(newConfigError("PrecedencePreservativeCrossover", "Expected OrderedChromosome, got %T", parents[0]))
 	}
 	p2, ok := parents[1].(*OrderedChromosome)
 	if !ok {
-		panic("Expects OrderedChromosome")
+		panic(newConfigError("PrecedencePreservativeCrossover", "Expected OrderedChromosome, got %T", parents[1]))
 	}
 
 	genesLen := p1.Genes().Len()
 
 	if genesLen != p2.Genes().Len() {
-		panic("Crossover do not support different chromosome size")
+		panic(newConfigError("PrecedencePreservativeCrossover", "Crossover do not support different chromosome size"))
 	}
 
 	mask := crossover.generateMask(genesLen)
 
-	log.Tracef("Cross with %v", mask)
+	if crossover.logEnabled(LogLevelTrace) {
+		crossover.tracef("Cross with %v", mask)
+	}
 
 	c1, c2 := crossover.crossover(p1, p2, mask)
 
@@ -54,7 +53,7 @@ func (crossover *PrecedencePreservativeCrossover) Crossover(parents Chromosomes)
 func (crossover *PrecedencePreservativeCrossover) generateMask(genesLen int) []int {
 	mask := make([]int, genesLen)
 	for i := 0; i < genesLen; i++ {
-		if rand.Intn(2) == 0 {
+		if crossover.randIntn(2) == 0 {
 			mask[i] = 1
 		} else {
 			mask[i] = 2
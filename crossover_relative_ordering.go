@@ -1,25 +1,25 @@
 package genetic_algorithm
 
-import (
-	log "github.com/cihub/seelog"
-)
-
 // Crossover for ordered chromosomes.
 // Tends to preserve relative order.
 //
 // Ordering genetic algorithms and deception. Hillol Kargupta, Ka. Lyanmoy Deb, David E. Goldberg (1992)
 // http://citeseerx.ist.psu.edu/viewdoc/summary?doi=10.1.1.94.6805
 type RelativeOrderingCrossover struct {
+	randomizer
+	configChecker
+	logging
+
 	preservedGenes int
 }
 
 func NewRelativeOrderingCrossover(preservedGenes int) *RelativeOrderingCrossover {
+	crossover := new(RelativeOrderingCrossover)
+
 	if preservedGenes < 1 {
-		panic("preservedGenes must be positive")
+		crossover.invalid("RelativeOrderingCrossover", "preservedGenes must be positive")
 	}
 
-	crossover := new(RelativeOrderingCrossover)
-
 	crossover.preservedGenes = preservedGenes
 
 	return crossover
@@ -31,31 +31,33 @@ func (crossover *RelativeOrderingCrossover) ParentsCount() int {
 
 func (crossover *RelativeOrderingCrossover) Crossover(parents Chromosomes) Chromosomes {
 	if len(parents) != crossover.ParentsCount() {
-		panic("Incorrect parents count")
+		panic(newConfigError("RelativeOrderingCrossover", "Expected %d parents, got %d", crossover.ParentsCount(), len(parents)))
 	}
 
 	p1, ok := parents[0].(*OrderedChromosome)
 	if !ok {
-		panic("Expects OrderedChromosome")
+		panic(newConfigError("RelativeOrderingCrossover", "Expected OrderedChromosome, got %T", parents[0]))
 	}
 	p2, ok := parents[1].(*OrderedChromosome)
 	if !ok {
-		panic("Expects OrderedChromosome")
+		panic(newConfigError("RelativeOrderingCrossover", "Expected OrderedChromosome, got %T", parents[1]))
 	}
 
 	genesLen := p1.Genes().Len()
 
 	if genesLen != p2.Genes().Len() {
-		panic("Crossover do not support different chromosome size")
+		panic(newConfigError("RelativeOrderingCrossover", "Crossover do not support different chromosome size"))
 	}
 
 	if genesLen <= crossover.preservedGenes {
-		log.Warnf("ROX will produce copies of parent because chromosome len lesser than preservedGenes")
+		crossover.warnf("ROX will produce copies of parent because chromosome len lesser than preservedGenes")
 	}
 
-	indexes := chooseDifferentRandomNumbers(crossover.preservedGenes, genesLen)
+	indexes := crossover.chooseDifferentRandomNumbers(crossover.preservedGenes, genesLen)
 
-	log.Tracef("Cross with %v", indexes)
+	if crossover.logEnabled(LogLevelTrace) {
+		crossover.tracef("Cross with %v", indexes)
+	}
 
 	c1, c2 := crossover.crossover(p1, p2, indexes)
 
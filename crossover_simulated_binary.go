@@ -0,0 +1,167 @@
+package genetic_algorithm
+
+import (
+	"math"
+)
+
+// Crossover for real chromosomes.
+// Simulates the spread of children produced by one point crossover of binary strings.
+// Children are placed symmetrically around the parents, the bigger distribution index
+// the closer children are to parents.
+// Bounded version is used, so children never leave the genes bounds.
+//
+// Simulated Binary Crossover for Continuous Search Space. Kalyanmoy Deb, Ram Bhushan Agrawal (1995)
+// http://citeseerx.ist.psu.edu/viewdoc/summary?doi=10.1.1.26.8485
+type SimulatedBinaryCrossover struct {
+	randomizer
+	configChecker
+	logging
+
+	distributionIndex         float64
+	geneProbability           float64
+	canProduceCopiesOfParents bool
+}
+
+// Typical values of distribution index are in [2:20]
+func NewSimulatedBinaryCrossover(distributionIndex float64) *SimulatedBinaryCrossover {
+	crossover := new(SimulatedBinaryCrossover)
+
+	if distributionIndex < 0 {
+		crossover.invalid("SimulatedBinaryCrossover", "distributionIndex can't be negative")
+	}
+
+	crossover.distributionIndex = distributionIndex
+	crossover.geneProbability = 0.5
+
+	return crossover
+}
+
+func (crossover *SimulatedBinaryCrossover) ParentsCount() int {
+	return 2
+}
+
+// Probability that a separate gene will be crossed. By default 0.5
+func (crossover *SimulatedBinaryCrossover) GeneProbability(probability float64) *SimulatedBinaryCrossover {
+	if probability > 1 || probability < 0 {
+		crossover.invalid("SimulatedBinaryCrossover", "Incorrect probability %v", probability)
+		return crossover
+	}
+
+	crossover.geneProbability = probability
+	return crossover
+}
+
+// When false at least one different gene will be crossed
+func (crossover *SimulatedBinaryCrossover) CanProduceCopiesOfParents(val bool) *SimulatedBinaryCrossover {
+	crossover.canProduceCopiesOfParents = val
+	return crossover
+}
+
+func (crossover *SimulatedBinaryCrossover) Crossover(parents Chromosomes) Chromosomes {
+	if len(parents) != crossover.ParentsCount() {
+		panic(newConfigError("SimulatedBinaryCrossover", "Expected %d parents, got %d", crossover.ParentsCount(), len(parents)))
+	}
+
+	p1, ok := parents[0].(*RealChromosome)
+	if !ok {
+		panic(newConfigError("SimulatedBinaryCrossover", "Expected RealChromosome, got %T", parents[0]))
+	}
+	p2, ok := parents[1].(*RealChromosome)
+	if !ok {
+		panic(newConfigError("SimulatedBinaryCrossover", "Expected RealChromosome, got %T", parents[1]))
+	}
+
+	genesLen := p1.Genes().Len()
+
+	if genesLen != p2.Genes().Len() {
+		panic(newConfigError("SimulatedBinaryCrossover", "Crossover do not support different chromosome size"))
+	}
+
+	c1, c2 := crossover.crossover(p1, p2)
+
+	return Chromosomes{c1, c2}
+}
+func (crossover *SimulatedBinaryCrossover) crossover(p1, p2 *RealChromosome) (c1, c2 *RealChromosome) {
+	p1genes := p1.RealGenes()
+	p2genes := p2.RealGenes()
+	bounds := p1.Bounds()
+
+	genesLen := p1genes.Len()
+
+	c1 = NewRealChromosome(make(RealGenes, genesLen), bounds)
+	c1genes := c1.RealGenes()
+
+	c2 = NewRealChromosome(make(RealGenes, genesLen), bounds)
+	c2genes := c2.RealGenes()
+
+	copy(c1genes, p1genes)
+	copy(c2genes, p2genes)
+
+	crossed := 0
+	for i := 0; i < genesLen; i++ {
+		if crossover.randFloat64() > crossover.geneProbability {
+			continue
+		}
+
+		if crossover.crossGene(c1genes, c2genes, bounds, i) {
+			crossed++
+		}
+	}
+
+	if crossed == 0 && !crossover.canProduceCopiesOfParents {
+		different := make([]int, 0, genesLen)
+		for i := 0; i < genesLen; i++ {
+			if p1genes[i] != p2genes[i] {
+				different = append(different, i)
+			}
+		}
+
+		if len(different) != 0 {
+			ind := different[crossover.randIntn(len(different))]
+			crossover.crossGene(c1genes, c2genes, bounds, ind)
+		}
+	}
+
+	if crossover.logEnabled(LogLevelTrace) {
+		crossover.tracef("Crossed %d genes", crossed)
+	}
+
+	return
+}
+
+// Returns false if gene can't be crossed because parents values are equal
+func (crossover *SimulatedBinaryCrossover) crossGene(c1genes, c2genes RealGenes, bounds *RealBounds, ind int) bool {
+	x1 := math.Min(c1genes[ind], c2genes[ind])
+	x2 := math.Max(c1genes[ind], c2genes[ind])
+	if x2-x1 < 1e-14 {
+		return false
+	}
+
+	lower := bounds.Lower(ind)
+	upper := bounds.Upper(ind)
+	u := crossover.randFloat64()
+
+	betaq := crossover.betaq(u, 1+2*(x1-lower)/(x2-x1))
+	v1 := bounds.Clamp(ind, 0.5*((x1+x2)-betaq*(x2-x1)))
+
+	betaq = crossover.betaq(u, 1+2*(upper-x2)/(x2-x1))
+	v2 := bounds.Clamp(ind, 0.5*((x1+x2)+betaq*(x2-x1)))
+
+	if crossover.randIntn(2) == 0 {
+		v1, v2 = v2, v1
+	}
+
+	c1genes[ind] = v1
+	c2genes[ind] = v2
+
+	return true
+}
+func (crossover *SimulatedBinaryCrossover) betaq(u, beta float64) float64 {
+	power := 1 / (crossover.distributionIndex + 1)
+	alpha := 2 - math.Pow(beta, -(crossover.distributionIndex+1))
+
+	if u <= 1/alpha {
+		return math.Pow(u*alpha, power)
+	}
+	return math.Pow(1/(2-u*alpha), power)
+}
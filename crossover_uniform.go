@@ -0,0 +1,33 @@
+package genetic_algorithm
+
+// Crossover for chromosomes of any kind.
+// Each gene is swapped between children with specified probability.
+type UniformCrossover struct {
+	*MaskCrossover
+
+	swapProbability float64
+}
+
+// SwapProbability=0.5 is the common choice
+func NewUniformCrossover(chromConstr EmptyChromosomeConstructor, swapProbability float64) *UniformCrossover {
+	crossover := new(UniformCrossover)
+
+	crossover.MaskCrossover = NewMaskCrossover(chromConstr, crossover.generateUniformMask)
+	if swapProbability > 1 || swapProbability < 0 {
+		crossover.invalid("UniformCrossover", "Incorrect probability %v", swapProbability)
+	}
+	crossover.swapProbability = swapProbability
+
+	return crossover
+}
+func (crossover *UniformCrossover) CanProduceCopiesOfParents(val bool) *UniformCrossover {
+	crossover.MaskCrossover.CanProduceCopiesOfParents(val)
+	return crossover
+}
+func (crossover *UniformCrossover) generateUniformMask(genesLen int) []bool {
+	mask := make([]bool, genesLen)
+	for i := 0; i < genesLen; i++ {
+		mask[i] = crossover.randFloat64() < crossover.swapProbability
+	}
+	return mask
+}
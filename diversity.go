@@ -0,0 +1,86 @@
+package genetic_algorithm
+
+import (
+	"math"
+)
+
+// Genes that can measure distance to other genes of the same type.
+// Used for genotypic diversity statistics.
+type GenesDistanceInterface interface {
+	Distance(genes GenesInterface) float64
+}
+
+// Genotypic diversity can't be tracked for genes without distance.
+// Checked on the initial population, so the error isn't raised in the middle of optimization.
+func checkGenesDistance(opts StatisticsOptionsInterface, population Chromosomes) error {
+	options, ok := opts.(*StatisticsDefaultOptions)
+	if !ok || !options.trackGenotypicDiversity || len(population) == 0 {
+		return nil
+	}
+
+	if _, ok := population[0].Genes().(GenesDistanceInterface); !ok {
+		return newConfigError("StatisticsDefault", "Genotypic diversity requires genes implementing GenesDistanceInterface")
+	}
+	return nil
+}
+
+// Mean distance between all pairs of chromosomes.
+// Returns NaN if genes don't implement GenesDistanceInterface.
+func meanPairwiseDistance(population Chromosomes) float64 {
+	if len(population) < 2 {
+		return 0
+	}
+
+	var sum float64
+	for i := 0; i < len(population); i++ {
+		genes, ok := population[i].Genes().(GenesDistanceInterface)
+		if !ok {
+			return math.NaN()
+		}
+
+		for j := i + 1; j < len(population); j++ {
+			sum += genes.Distance(population[j].Genes())
+		}
+	}
+
+	pairs := len(population) * (len(population) - 1) / 2
+	return sum / float64(pairs)
+}
+
+// Shannon entropy in bits of gene values at each locus
+func locusEntropy(population Chromosomes) []float64 {
+	genesLen := population[0].Genes().Len()
+	entropy := make([]float64, genesLen)
+
+	for locus := 0; locus < genesLen; locus++ {
+		counts := make(map[interface{}]int)
+		for _, chrom := range population {
+			counts[chrom.Genes().Get(locus)]++
+		}
+
+		for _, count := range counts {
+			p := float64(count) / float64(len(population))
+			entropy[locus] -= p * math.Log2(p)
+		}
+	}
+
+	return entropy
+}
+
+// Population standard deviation of costs
+func costStdDev(population Chromosomes) float64 {
+	costs := make([]float64, len(population))
+	for i, chrom := range population {
+		costs[i] = chrom.Cost()
+	}
+	return math.Sqrt(math.Max(0, pvarianceFloat64(costs)))
+}
+
+// Number of different genotypes, compared by hash
+func uniqueGenotypes(population Chromosomes) int {
+	hashes := make(map[uint64]bool, len(population))
+	for _, chrom := range population {
+		hashes[HashGenes(chrom.Genes())] = true
+	}
+	return len(hashes)
+}
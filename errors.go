@@ -0,0 +1,82 @@
+package genetic_algorithm
+
+import (
+	"fmt"
+)
+
+// Error of optimizer or operator configuration
+type ConfigError struct {
+	// Name of the misconfigured optimizer or operator
+	Component string
+	Message   string
+}
+
+func newConfigError(component, format string, args ...interface{}) *ConfigError {
+	return &ConfigError{component, fmt.Sprintf(format, args...)}
+}
+func (err *ConfigError) Error() string {
+	return fmt.Sprintf("%s: %s", err.Component, err.Message)
+}
+
+// Operators and other components which parameters can be checked before optimization.
+// Constructors and builder methods don't panic on incorrect parameters, errors are reported by Check.
+type CheckableInterface interface {
+	Check() error
+}
+
+// Components which parameters depend on the chromosome size
+type ChromSizeCheckableInterface interface {
+	CheckChromSize(chromSize int) error
+}
+
+// Keeps the first configuration error found by constructors and builder methods
+type configChecker struct {
+	configErr error
+}
+
+func (checker *configChecker) invalid(component, format string, args ...interface{}) {
+	if checker.configErr == nil {
+		checker.configErr = newConfigError(component, format, args...)
+	}
+}
+func (checker *configChecker) Check() error {
+	return checker.configErr
+}
+
+// Returns error of the first component that fails the check
+func checkAll(components ...interface{}) error {
+	for _, component := range components {
+		if checkable, ok := component.(CheckableInterface); ok {
+			if err := checkable.Check(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Returns error of the first component that doesn't fit chromosomes of the given size
+func checkAllChromSize(chromSize int, components ...interface{}) error {
+	for _, component := range components {
+		if checkable, ok := component.(ChromSizeCheckableInterface); ok {
+			if err := checkable.CheckChromSize(chromSize); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Converts panic with *ConfigError into returned error.
+// Configuration errors that can be found only during optimization are raised as panics.
+// Other panics are programming errors, e.g. genes of a wrong type passed to a chromosome
+// or negative count passed to a selector, they aren't converted.
+func recoverConfigError(err *error) {
+	if r := recover(); r != nil {
+		configErr, ok := r.(*ConfigError)
+		if !ok {
+			panic(r)
+		}
+		*err = configErr
+	}
+}
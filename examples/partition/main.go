@@ -8,6 +8,7 @@ import (
 	. "github.com/WiseBird/genetic_algorithm"
 	"math"
 	log "github.com/cihub/seelog"
+	"github.com/WiseBird/genetic_algorithm/seeloglogger"
 )
 
 var (
@@ -16,7 +17,8 @@ var (
 	finalProduct = 3120
 )
 
-func Cost(c ChromosomeInterface) float64 {
+// The sum is the objective, the product is the constraint
+func Cost(c ChromosomeInterface) ConstrainedCost {
 	bc := c.(*BinaryChromosome)
 	sum := 0
 	prod := 1
@@ -34,7 +36,7 @@ func Cost(c ChromosomeInterface) float64 {
 	sumDiff := float64(sum - finalSum)
 	prodDiff := float64(prod - finalProduct)
 
-	return math.Abs(sumDiff) + math.Abs(prodDiff)
+	return ConstrainedCost{Objective: math.Abs(sumDiff), Violation: math.Abs(prodDiff)}
 }
 
 func main() {
@@ -60,11 +62,13 @@ func createOptimizer() OptimizerInterface {
 		Selector(NewRouletteWheelCostWeightingSelector()).
 		Crossover(NewOnePointCrossover(NewEmptyBinaryChromosome)).
 		Mutator(NewBinaryMutator(mutationProb)).
-		CostFunction(Cost).
+		ConstrainedCostFunction(Cost).
+		ConstraintHandler(NewFeasibilityRules()).
 		StopCriterion(NewStopCriterionDefault().
 			Max_Generations(generations).
 			Min_Cost(0).
 			Max_GenerationsWithoutImprovements(15)).
+		Logger(seeloglogger.New(nil)).
 		PopSize(popSize).
 		ChromSize(chromSize)
 }
@@ -8,6 +8,7 @@ import (
 	. "github.com/WiseBird/genetic_algorithm"
 	"github.com/WiseBird/genetic_algorithm/plotting"
 	log "github.com/cihub/seelog"
+	"github.com/WiseBird/genetic_algorithm/seeloglogger"
 	partition "github.com/WiseBird/genetic_algorithm/examples/partition"
 )
 
@@ -46,11 +47,12 @@ func createOptimizer() OptimizerInterface {
 		Selector(NewRouletteWheelCostWeightingSelector()).
 		Crossover(NewOnePointCrossover(NewEmptyBinaryChromosome)).
 		Mutator(NewBinaryMutator(mutationProb)).
-		CostFunction(partition.Cost).
+		ConstrainedCostFunction(partition.Cost).
 		StopCriterion(NewStopCriterionDefault().
 			Max_Generations(generations).
 			Min_Cost(0).
 			Max_GenerationsWithoutImprovements(maxGenerationsWithoutImprovements)).
+		Logger(seeloglogger.New(nil)).
 		PopSize(popSize).
 		ChromSize(chromSize)
 }
@@ -1,6 +1,6 @@
 /*
 	Compares performance of two optimizers with different mutation probability.
-	Draws min/mean cost plots and logs some statistics.
+	Draws min/mean cost plots, logs some statistics and prints statistical comparison of final costs.
 */
 
 package main
@@ -9,8 +9,10 @@ import (
 	. "github.com/WiseBird/genetic_algorithm"
 	"github.com/WiseBird/genetic_algorithm/plotting"
 	log "github.com/cihub/seelog"
+	"github.com/WiseBird/genetic_algorithm/seeloglogger"
 	partition "github.com/WiseBird/genetic_algorithm/examples/partition"
 	"fmt"
+	"os"
 )
 
 func main() {
@@ -57,6 +59,14 @@ func main() {
 
 	log.Warnf("Avg minCost1: %v", statisticsAggregator1.MinCost())
 	log.Warnf("Avg minCost2: %v", statisticsAggregator2.MinCost())
+
+	NewComparison().
+		Configuration(fmt.Sprintf("m=%.2f", pmutate1), createOptimizer(pmutate1)).
+		Configuration(fmt.Sprintf("m=%.2f", pmutate2), createOptimizer(pmutate2)).
+		Iterations(100).
+		Target(0).
+		Compare().
+		WriteTable(os.Stdout)
 }
 
 func createOptimizer(mutationProb float64) OptimizerInterface {
@@ -72,11 +82,12 @@ func createOptimizer(mutationProb float64) OptimizerInterface {
 		Selector(NewRouletteWheelCostWeightingSelector()).
 		Crossover(NewOnePointCrossover(NewEmptyBinaryChromosome)).
 		Mutator(NewBinaryMutator(mutationProb)).
-		CostFunction(partition.Cost).
+		ConstrainedCostFunction(partition.Cost).
 		StopCriterion(NewStopCriterionDefault().
 			Max_Generations(generations).
 			Min_Cost(0).
 			Max_GenerationsWithoutImprovements(maxGenerationsWithoutImprovements)).
+		Logger(seeloglogger.New(nil)).
 		PopSize(popSize).
 		ChromSize(chromSize)
 }
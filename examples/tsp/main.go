@@ -8,6 +8,7 @@ import (
 	. "github.com/WiseBird/genetic_algorithm"
 	"math"
 	log "github.com/cihub/seelog"
+	"github.com/WiseBird/genetic_algorithm/seeloglogger"
 )
 
 
@@ -69,6 +70,7 @@ func createOptimizer() OptimizerInterface {
 		StopCriterion(NewStopCriterionDefault().
 			Max_Generations(generations).
 			Max_GenerationsWithoutImprovements(15)).
+		Logger(seeloglogger.New(nil)).
 		PopSize(popSize).
 		ChromSize(chromSize)
 }
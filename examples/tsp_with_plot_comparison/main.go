@@ -7,6 +7,7 @@ package main
 import (
 	. "github.com/WiseBird/genetic_algorithm"
 	log "github.com/cihub/seelog"
+	"github.com/WiseBird/genetic_algorithm/seeloglogger"
 	"github.com/WiseBird/genetic_algorithm/plotting"
 	tsp "github.com/WiseBird/genetic_algorithm/examples/tsp"
 )
@@ -102,6 +103,7 @@ func createOptimizer(ver int) OptimizerInterface {
 		StopCriterion(NewStopCriterionDefault().
 			Max_Generations(generations).
 			Max_GenerationsWithoutImprovements(15)).
+		Logger(seeloglogger.New(nil)).
 		PopSize(popSize).
 		ChromSize(chromSize)
 
@@ -1,9 +1,8 @@
 package genetic_algorithm
 
 import (
-	"fmt"
 	"math"
-	"math/rand"
+	"sync"
 )
 
 // https://hg.python.org/cpython/file/4480506137ed/Lib/statistics.py#l453
@@ -73,17 +72,14 @@ func meanFloat64ArrIter(count int, value func(int) []float64) []float64 {
 	return meanFloat64Arr(values)
 }
 
+// Rounded to the nearest integer
 func meanInt64(values []int64) int64 {
-	if len(values) == 0 {
-		return 0
-	}
-
-	var sum int64
-	for _, val := range values {
-		sum += val
-	}
-	return sum / int64(len(values))
+	return meanInt64Iter(len(values), func(i int) int64 {
+		return values[i]
+	})
 }
+
+// Rounded to the nearest integer
 func meanInt64Iter(count int, value func(int) int64) int64 {
 	if count == 0 {
 		return 0
@@ -93,7 +89,88 @@ func meanInt64Iter(count int, value func(int) int64) int64 {
 	for i := 0; i < count; i++ {
 		sum += value(i)
 	}
-	return sum / int64(count)
+	return int64(math.Floor(float64(sum)/float64(count) + 0.5))
+}
+
+// Values of each position across arrays which have it, so columns of shorter arrays' positions are shorter
+func columnsFloat64Arr(values [][]float64) [][]float64 {
+	length := 0
+	for _, arr := range values {
+		if length < len(arr) {
+			length = len(arr)
+		}
+	}
+
+	columns := make([][]float64, length)
+	for i := 0; i < length; i++ {
+		for _, arr := range values {
+			if len(arr) > i {
+				columns[i] = append(columns[i], arr[i])
+			}
+		}
+	}
+
+	return columns
+}
+
+// Quantile with linear interpolation between closest ranks.
+// Expects sorted values
+func quantileFloat64(sorted []float64, q float64) float64 {
+	if len(sorted) == 0 {
+		return math.NaN()
+	}
+
+	pos := q * float64(len(sorted)-1)
+	lower := int(math.Floor(pos))
+	if lower >= len(sorted)-1 {
+		return sorted[len(sorted)-1]
+	}
+	if lower < 0 {
+		return sorted[0]
+	}
+
+	return sorted[lower] + (pos-float64(lower))*(sorted[lower+1]-sorted[lower])
+}
+
+// Two-sided 95% quantiles of Student's t-distribution for 1..30 degrees of freedom
+var tQuantiles95 = []float64{
+	12.706, 4.303, 3.182, 2.776, 2.571, 2.447, 2.365, 2.306, 2.262, 2.228,
+	2.201, 2.179, 2.160, 2.145, 2.131, 2.120, 2.110, 2.101, 2.093, 2.086,
+	2.080, 2.074, 2.069, 2.064, 2.060, 2.056, 2.052, 2.048, 2.045, 2.042,
+}
+
+// Quantiles for more degrees of freedom, the last one is the normal quantile
+var tQuantiles95Large = []struct{ df, t float64 }{
+	{30, 2.042}, {40, 2.021}, {60, 2.000}, {120, 1.980}, {math.Inf(1), 1.960},
+}
+
+// Two-sided 95% quantile of Student's t-distribution.
+// Above 30 degrees of freedom quantile is interpolated linearly in 1/df.
+func tQuantile95(df int) float64 {
+	if df <= len(tQuantiles95) {
+		return tQuantiles95[df-1]
+	}
+
+	for i := 1; i < len(tQuantiles95Large); i++ {
+		lower, upper := tQuantiles95Large[i-1], tQuantiles95Large[i]
+		if float64(df) <= upper.df {
+			fraction := (1/lower.df - 1/float64(df)) / (1/lower.df - 1/upper.df)
+			return lower.t + fraction*(upper.t-lower.t)
+		}
+	}
+	return tQuantiles95Large[len(tQuantiles95Large)-1].t
+}
+
+// Bounds of 95% confidence interval of the mean.
+// Interval collapses to the value when there is a single value
+func confidenceInterval95(values []float64) (lower, upper float64) {
+	mean := meanFloat64(values)
+	if len(values) < 2 {
+		return mean, mean
+	}
+
+	halfWidth := tQuantile95(len(values)-1) * math.Sqrt(varianceFloat64(values)/float64(len(values)))
+	return mean - halfWidth, mean + halfWidth
 }
 
 // Return sum of square deviations
@@ -123,38 +200,6 @@ func pvarianceFloat64(values []float64) float64 {
 	return ssFloat64(values) / float64(len(values))
 }
 
-func chooseTwoPointCrossSection(genesLen int, canProduceCopiesOfParents bool) (crossPoint1 int, crossPoint2 int) {
-	crossPoint1 = rand.Intn(genesLen)
-
-	if !canProduceCopiesOfParents && crossPoint1 == 0 {
-		crossPoint2 = rand.Intn(genesLen-1) + 1
-	} else {
-		crossPoint2 = rand.Intn(genesLen-crossPoint1) + 1 + crossPoint1
-	}
-	return
-}
-
-func chooseDifferentRandomNumbers(count, upperBound int) []int {
-	if upperBound < count {
-		panic(fmt.Sprintf("Can't select %d different numbers on inerval [0:%d)", count, upperBound))
-	}
-
-	numbersMap := make(map[int]bool, count)
-	numbersList := make([]int, count)
-	for i := 0; i < count; i++ {
-		for {
-			number := rand.Intn(upperBound)
-			if !numbersMap[number] {
-				numbersMap[number] = true
-				numbersList[i] = number
-				break
-			}
-		}
-	}
-
-	return numbersList
-}
-
 func round(val float64) int {
 	return int(roundEx(val, .5, 0))
 }
@@ -173,3 +218,29 @@ func roundEx(val float64, roundOn float64, places int) (newVal float64) {
 	newVal = round / pow
 	return
 }
+
+// Calls fn for each index in several goroutines
+func parallelFor(indexes []int, workers int, fn func(i int)) {
+	if workers > len(indexes) {
+		workers = len(indexes)
+	}
+
+	jobs := make(chan int, len(indexes))
+	for _, i := range indexes {
+		jobs <- i
+	}
+	close(jobs)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+
+			for i := range jobs {
+				fn(i)
+			}
+		}()
+	}
+	wg.Wait()
+}
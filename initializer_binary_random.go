@@ -1,10 +1,7 @@
 package genetic_algorithm
 
-import (
-	"math/rand"
-)
-
 type BinaryRandomInitializer struct {
+	randomizer
 }
 
 func NewBinaryRandomInitializer() *BinaryRandomInitializer {
@@ -19,7 +16,7 @@ func (initializer *BinaryRandomInitializer) Init(count, chromSize int) Chromosom
 
 		genes := make(BinaryGenes, chromSize)
 		for geneInd := 0; geneInd < chromSize; geneInd++ {
-			x := rand.Intn(2)
+			x := initializer.randIntn(2)
 			genes[geneInd] = x == 1
 		}
 
@@ -0,0 +1,46 @@
+package genetic_algorithm
+
+// Fills genes with values uniformly distributed within bounds
+type IntegerRandomInitializer struct {
+	randomizer
+	configChecker
+
+	bounds *IntegerBounds
+}
+
+func NewIntegerRandomInitializer(bounds *IntegerBounds) *IntegerRandomInitializer {
+	initializer := new(IntegerRandomInitializer)
+
+	if bounds == nil {
+		initializer.invalid("IntegerRandomInitializer", "Bounds must be set")
+	}
+
+	initializer.bounds = bounds
+
+	return initializer
+}
+func (initializer *IntegerRandomInitializer) Check() error {
+	if initializer.configErr != nil {
+		return initializer.configErr
+	}
+	return initializer.bounds.Check()
+}
+func (initializer *IntegerRandomInitializer) Init(count, chromSize int) Chromosomes {
+	if !initializer.bounds.Fits(chromSize) {
+		panic(newConfigError("IntegerRandomInitializer", "Bounds do not fit chromosome of length %d", chromSize))
+	}
+
+	result := make([]ChromosomeInterface, count)
+
+	for chromeInd := 0; chromeInd < count; chromeInd++ {
+
+		genes := make(IntegerGenes, chromSize)
+		for geneInd := 0; geneInd < chromSize; geneInd++ {
+			genes[geneInd] = initializer.bounds.Lower(geneInd) + initializer.randIntn(initializer.bounds.Size(geneInd))
+		}
+
+		result[chromeInd] = NewIntegerChromosome(genes, initializer.bounds)
+	}
+
+	return result
+}
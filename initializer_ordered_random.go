@@ -1,10 +1,7 @@
 package genetic_algorithm
 
-import (
-	"math/rand"
-)
-
 type OrderedRandomInitializer struct {
+	randomizer
 }
 
 func NewOrderedRandomInitializer() *OrderedRandomInitializer {
@@ -20,7 +17,7 @@ func (initializer *OrderedRandomInitializer) Init(count, chromSize int) Chromoso
 		genes := make(OrderedGenes, chromSize)
 		// http://en.wikipedia.org/wiki/Fisher%E2%80%93Yates_shuffle
 		for geneInd := 0; geneInd < chromSize; geneInd++ {
-			randInd := rand.Intn(geneInd + 1)
+			randInd := initializer.randIntn(geneInd + 1)
 
 			genes[geneInd] = genes[randInd]
 			genes[randInd] = geneInd
@@ -0,0 +1,46 @@
+package genetic_algorithm
+
+// Fills genes with values uniformly distributed within bounds
+type RealRandomInitializer struct {
+	randomizer
+	configChecker
+
+	bounds *RealBounds
+}
+
+func NewRealRandomInitializer(bounds *RealBounds) *RealRandomInitializer {
+	initializer := new(RealRandomInitializer)
+
+	if bounds == nil {
+		initializer.invalid("RealRandomInitializer", "Bounds must be set")
+	}
+
+	initializer.bounds = bounds
+
+	return initializer
+}
+func (initializer *RealRandomInitializer) Check() error {
+	if initializer.configErr != nil {
+		return initializer.configErr
+	}
+	return initializer.bounds.Check()
+}
+func (initializer *RealRandomInitializer) Init(count, chromSize int) Chromosomes {
+	if !initializer.bounds.Fits(chromSize) {
+		panic(newConfigError("RealRandomInitializer", "Bounds do not fit chromosome of length %d", chromSize))
+	}
+
+	result := make([]ChromosomeInterface, count)
+
+	for chromeInd := 0; chromeInd < count; chromeInd++ {
+
+		genes := make(RealGenes, chromSize)
+		for geneInd := 0; geneInd < chromSize; geneInd++ {
+			genes[geneInd] = initializer.bounds.Lower(geneInd) + initializer.randFloat64()*initializer.bounds.Range(geneInd)
+		}
+
+		result[chromeInd] = NewRealChromosome(genes, initializer.bounds)
+	}
+
+	return result
+}
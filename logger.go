@@ -0,0 +1,81 @@
+package genetic_algorithm
+
+type LogLevel int
+
+const (
+	LogLevelTrace LogLevel = iota
+	LogLevelDebug
+	LogLevelInfo
+	LogLevelWarn
+	LogLevelError
+)
+
+func (level LogLevel) String() string {
+	switch level {
+	case LogLevelTrace:
+		return "trace"
+	case LogLevelDebug:
+		return "debug"
+	case LogLevelInfo:
+		return "info"
+	case LogLevelWarn:
+		return "warn"
+	case LogLevelError:
+		return "error"
+	}
+	return "unknown"
+}
+
+// Logger used by optimizer and operators.
+// By default nothing is logged. Adapters for seelog and log/slog are in the seeloglogger and sloglogger packages.
+type LoggerInterface interface {
+	// Messages of the level are neither formatted nor written when false
+	Enabled(level LogLevel) bool
+	Logf(level LogLevel, format string, args ...interface{})
+}
+
+// Components that write logs.
+// Optimizer passes its logger to operators, statistics and stop criterion.
+type LoggableInterface interface {
+	SetLogger(logger LoggerInterface)
+}
+
+// Logger that writes nothing
+type NopLogger struct{}
+
+func (logger NopLogger) Enabled(level LogLevel) bool                             { return false }
+func (logger NopLogger) Logf(level LogLevel, format string, args ...interface{}) {}
+
+// Writes logs to the logger if it is set.
+// Arguments of the messages are boxed before the level is checked,
+// so hot paths should check logEnabled first.
+type logging struct {
+	logger LoggerInterface
+}
+
+func (l *logging) SetLogger(logger LoggerInterface) {
+	l.logger = logger
+}
+func (l *logging) logEnabled(level LogLevel) bool {
+	return l.logger != nil && l.logger.Enabled(level)
+}
+func (l *logging) logf(level LogLevel, format string, args ...interface{}) {
+	if l.logEnabled(level) {
+		l.logger.Logf(level, format, args...)
+	}
+}
+func (l *logging) tracef(format string, args ...interface{}) {
+	l.logf(LogLevelTrace, format, args...)
+}
+func (l *logging) debugf(format string, args ...interface{}) {
+	l.logf(LogLevelDebug, format, args...)
+}
+func (l *logging) infof(format string, args ...interface{}) {
+	l.logf(LogLevelInfo, format, args...)
+}
+func (l *logging) warnf(format string, args ...interface{}) {
+	l.logf(LogLevelWarn, format, args...)
+}
+func (l *logging) errorf(format string, args ...interface{}) {
+	l.logf(LogLevelError, format, args...)
+}
@@ -1,10 +1,26 @@
 package genetic_algorithm
 
 // Mutator interface
+// Mutated chromosomes must be marked dirty, see DirtyChromosomeInterface.
 type MutatorInterface interface {
 	Mutate(Chromosomes)
 }
 
+// Mutators which never mutate the first chromosomes of population, assuming they are the best ones.
+// MutateAll mutates all chromosomes, e.g. offspring which doesn't contain the best ones.
+type ElitistMutatorInterface interface {
+	MutateAll(Chromosomes)
+}
+
+// Mutates all offspring, mutators with elitism don't skip the first children
+func mutateOffspring(mutator MutatorInterface, offspring Chromosomes) {
+	if elitist, ok := mutator.(ElitistMutatorInterface); ok {
+		elitist.MutateAll(offspring)
+		return
+	}
+	mutator.Mutate(offspring)
+}
+
 var (
 	NopMutator = &nopMutator{}
 )
@@ -14,7 +14,7 @@ func NewBinaryMutator(probability float64) *MutatorGeneBase {
 func (mutator *BinaryMutator) MutateCromosome(chrom ChromosomeInterface, ind int) {
 	bc, ok := chrom.(*BinaryChromosome)
 	if !ok {
-		panic("Expects BinaryChromosome")
+		panic(newConfigError("BinaryMutator", "Expected BinaryChromosome, got %T", chrom))
 	}
 
 	bc.genes[ind] = !bc.genes[ind]
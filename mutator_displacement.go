@@ -1,9 +1,5 @@
 package genetic_algorithm
 
-import (
-	"math/rand"
-)
-
 // Mutator selects some part of the chromosome and places it in random position
 type DisplacementMutator struct {
 	*MutatorIntervalBase
@@ -30,7 +26,7 @@ func (mutator *DisplacementMutator) MutateGenes(genes GenesInterface, from, to i
 }
 func (mutator *DisplacementMutator) chooseInsertPoint(genesLen, from, to int) int {
 	possiblePoints := genesLen - (to - from)
-	point := rand.Intn(possiblePoints)
+	point := mutator.randIntn(possiblePoints)
 	if point >= from {
 		point += to - from + 1
 	}
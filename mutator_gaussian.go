@@ -0,0 +1,55 @@
+package genetic_algorithm
+
+// Mutator for real chromosomes
+// Adds normally distributed noise to the gene. Result is clamped to the gene bounds.
+type GaussianMutator struct {
+	randomizer
+
+	sigmas []float64
+}
+
+// Probability is applied to each element separately.
+// Sigma is the same for all genes.
+func NewGaussianMutator(probability, sigma float64) *MutatorGeneBase {
+	return NewPerGeneGaussianMutator(probability, []float64{sigma})
+}
+
+// Probability is applied to each element separately.
+// Sigmas are specified for each gene separately.
+func NewPerGeneGaussianMutator(probability float64, sigmas []float64) *MutatorGeneBase {
+	gaussian := new(GaussianMutator)
+	gaussian.sigmas = sigmas
+
+	mutator := NewGeneBaseMutator(gaussian, probability)
+
+	if len(sigmas) == 0 {
+		mutator.invalid("GaussianMutator", "Sigmas must be set")
+	}
+	for _, sigma := range sigmas {
+		if sigma < 0 {
+			mutator.invalid("GaussianMutator", "Sigma can't be negative")
+		}
+	}
+
+	return mutator
+}
+func (mutator *GaussianMutator) MutateCromosome(chrom ChromosomeInterface, ind int) {
+	rc, ok := chrom.(*RealChromosome)
+	if !ok {
+		panic(newConfigError("GaussianMutator", "Expected RealChromosome, got %T", chrom))
+	}
+
+	rc.genes[ind] = rc.bounds.Clamp(ind, rc.genes[ind]+mutator.randNormFloat64()*mutator.sigma(ind))
+}
+func (mutator *GaussianMutator) CheckChromSize(chromSize int) error {
+	if len(mutator.sigmas) != 1 && len(mutator.sigmas) != chromSize {
+		return newConfigError("GaussianMutator", "Expected 1 or %d sigmas, got %d", chromSize, len(mutator.sigmas))
+	}
+	return nil
+}
+func (mutator *GaussianMutator) sigma(ind int) float64 {
+	if len(mutator.sigmas) == 1 {
+		return mutator.sigmas[0]
+	}
+	return mutator.sigmas[ind]
+}
@@ -1,8 +1,6 @@
 package genetic_algorithm
 
 import (
-	"fmt"
-	log "github.com/cihub/seelog"
 	"math"
 	"math/rand"
 )
@@ -17,6 +15,9 @@ const (
 // Base class for mutators that mutate separate genes
 type MutatorGeneBase struct {
 	MutatorGeneBaseVirtualMInterface
+	randomizer
+	configChecker
+	logging
 
 	probability float64
 	elitism     int
@@ -29,12 +30,12 @@ type MutatorGeneBaseVirtualMInterface interface {
 }
 
 func NewGeneBaseMutator(virtual MutatorGeneBaseVirtualMInterface, probability float64) *MutatorGeneBase {
+	mutator := new(MutatorGeneBase)
+
 	if probability > 1 || probability < 0 {
-		panic(fmt.Sprintf("Incorrect probability %v", probability))
+		mutator.invalid("Mutator", "Incorrect probability %v", probability)
 	}
 
-	mutator := new(MutatorGeneBase)
-
 	mutator.MutatorGeneBaseVirtualMInterface = virtual
 	mutator.probability = probability
 	mutator.elitism = 1
@@ -58,7 +59,8 @@ func (mutator *MutatorGeneBase) ExactCount() *MutatorGeneBase {
 // The best chromosome[s] can't be mutated
 func (mutator *MutatorGeneBase) WithElitism(count int) *MutatorGeneBase {
 	if count < 0 {
-		panic("Elitism can't be negative")
+		mutator.invalid("Mutator", "Elitism can't be negative")
+		return mutator
 	}
 
 	mutator.elitism = count
@@ -71,35 +73,73 @@ func (mutator *MutatorGeneBase) WithoutElitism() *MutatorGeneBase {
 	return mutator
 }
 
+// Passes generator to the virtual methods implementer as well
+func (mutator *MutatorGeneBase) SetRand(rnd *rand.Rand) {
+	mutator.randomizer.SetRand(rnd)
+
+	if randomized, ok := mutator.MutatorGeneBaseVirtualMInterface.(RandomizedInterface); ok {
+		randomized.SetRand(rnd)
+	}
+}
+
+// Passes generation number to the virtual methods implementer if it needs one
+func (mutator *MutatorGeneBase) SetGeneration(generation int) {
+	if generationAware, ok := mutator.MutatorGeneBaseVirtualMInterface.(GenerationAwareInterface); ok {
+		generationAware.SetGeneration(generation)
+	}
+}
+
+// Passes the check to the virtual methods implementer if its parameters depend on the chromosome size
+func (mutator *MutatorGeneBase) CheckChromSize(chromSize int) error {
+	if checkable, ok := mutator.MutatorGeneBaseVirtualMInterface.(ChromSizeCheckableInterface); ok {
+		return checkable.CheckChromSize(chromSize)
+	}
+	return nil
+}
+
 func (mutator *MutatorGeneBase) Mutate(population Chromosomes) {
+	mutator.mutate(population, mutator.elitism)
+}
+
+// Mutates chromosomes regardless of elitism
+func (mutator *MutatorGeneBase) MutateAll(population Chromosomes) {
+	mutator.mutate(population, 0)
+}
+func (mutator *MutatorGeneBase) mutate(population Chromosomes, elitism int) {
 	switch mutator.kind {
 	case MutatorOneByOneType:
-		mutator.mutateOneByOne(population)
+		mutator.mutateOneByOne(population, elitism)
 	case MutatorExactCountType:
-		mutator.mutateExactCount(population)
+		mutator.mutateExactCount(population, elitism)
 	}
 }
-func (mutator *MutatorGeneBase) mutateOneByOne(population Chromosomes) {
+func (mutator *MutatorGeneBase) mutateOneByOne(population Chromosomes, elitism int) {
 	m := 0
 	for ind, chrom := range population {
-		if mutator.elitism > ind {
+		if elitism > ind {
 			continue
 		}
 
-		for i := 0; i < chrom.Genes().Len(); i++ {
-			if rand.Float64() > mutator.probability {
+		genesLen := chrom.Genes().Len()
+		for i := 0; i < genesLen; i++ {
+			if mutator.randFloat64() > mutator.probability {
 				continue
 			}
 
-			log.Tracef("Mutate: %v, at %d\n", chrom, i)
+			if mutator.logEnabled(LogLevelTrace) {
+				mutator.tracef("Mutate: %v, at %d", chrom, i)
+			}
 			mutator.MutateCromosome(chrom, i)
+			MarkDirty(chrom)
 			m++
 		}
 	}
 
-	log.Debugf("Elems mutated: %d", m)
+	if mutator.logEnabled(LogLevelDebug) {
+		mutator.debugf("Elems mutated: %d", m)
+	}
 }
-func (mutator *MutatorGeneBase) mutateExactCount(population Chromosomes) {
+func (mutator *MutatorGeneBase) mutateExactCount(population Chromosomes, elitism int) {
 	popLen := len(population)
 	if popLen == 0 {
 		return
@@ -107,15 +147,20 @@ func (mutator *MutatorGeneBase) mutateExactCount(population Chromosomes) {
 
 	genesLen := population[0].Genes().Len()
 
-	chromsToMutate := popLen - mutator.elitism
+	chromsToMutate := popLen - elitism
 	elementsToMutate := int(math.Floor(mutator.probability * float64(chromsToMutate*genesLen)))
-	log.Debugf("ElemsToMutate: %d", elementsToMutate)
+	if mutator.logEnabled(LogLevelDebug) {
+		mutator.debugf("ElemsToMutate: %d", elementsToMutate)
+	}
 
 	for i := 0; i < elementsToMutate; i++ {
-		chromInd := rand.Intn(popLen-mutator.elitism) + mutator.elitism
-		elemInd := rand.Intn(genesLen)
+		chromInd := mutator.randIntn(popLen-elitism) + elitism
+		elemInd := mutator.randIntn(genesLen)
 
-		log.Tracef("Mutate: %v, at %d\n", population[chromInd], elemInd)
+		if mutator.logEnabled(LogLevelTrace) {
+			mutator.tracef("Mutate: %v, at %d", population[chromInd], elemInd)
+		}
 		mutator.MutateCromosome(population[chromInd], elemInd)
+		MarkDirty(population[chromInd])
 	}
 }
@@ -0,0 +1,37 @@
+package genetic_algorithm
+
+// Mutator for integer chromosomes
+// Adds or subtracts small random value from the gene. Result is clamped to the gene bounds.
+type IntegerCreepMutator struct {
+	randomizer
+
+	maxStep int
+}
+
+// Probability is applied to each element separately.
+// Step is randomly chosen from [1:maxStep]
+func NewIntegerCreepMutator(probability float64, maxStep int) *MutatorGeneBase {
+	creep := new(IntegerCreepMutator)
+	creep.maxStep = maxStep
+
+	mutator := NewGeneBaseMutator(creep, probability)
+
+	if maxStep < 1 {
+		mutator.invalid("IntegerCreepMutator", "maxStep must be positive")
+	}
+
+	return mutator
+}
+func (mutator *IntegerCreepMutator) MutateCromosome(chrom ChromosomeInterface, ind int) {
+	ic, ok := chrom.(*IntegerChromosome)
+	if !ok {
+		panic(newConfigError("IntegerCreepMutator", "Expected IntegerChromosome, got %T", chrom))
+	}
+
+	step := mutator.randIntn(mutator.maxStep) + 1
+	if mutator.randIntn(2) == 0 {
+		step = -step
+	}
+
+	ic.genes[ind] = ic.bounds.Clamp(ind, ic.genes[ind]+step)
+}
@@ -0,0 +1,32 @@
+package genetic_algorithm
+
+// Mutator for integer chromosomes
+// Replaces the gene with another value from the gene bounds.
+type IntegerRandomResetMutator struct {
+	randomizer
+}
+
+// Probability is applied to each element separately.
+func NewIntegerRandomResetMutator(probability float64) *MutatorGeneBase {
+	mutator := NewGeneBaseMutator(new(IntegerRandomResetMutator), probability)
+
+	return mutator
+}
+func (mutator *IntegerRandomResetMutator) MutateCromosome(chrom ChromosomeInterface, ind int) {
+	ic, ok := chrom.(*IntegerChromosome)
+	if !ok {
+		panic(newConfigError("IntegerRandomResetMutator", "Expected IntegerChromosome, got %T", chrom))
+	}
+
+	size := ic.bounds.Size(ind)
+	if size == 1 {
+		return
+	}
+
+	val := ic.bounds.Lower(ind) + mutator.randIntn(size-1)
+	if val >= ic.genes[ind] {
+		val++
+	}
+
+	ic.genes[ind] = val
+}
@@ -1,10 +1,5 @@
 package genetic_algorithm
 
-import (
-	"fmt"
-	"math/rand"
-)
-
 const (
 	mutatorInvertExactLen      = 0
 	mutatorInvertPercentageLen = 1
@@ -13,6 +8,8 @@ const (
 // Base class for mutators that mutate some interval of genes
 type MutatorIntervalBase struct {
 	MutatorIntervalBaseVirtualMInterface
+	randomizer
+	configChecker
 
 	probability           float64
 	chromosomeConstructor EmptyChromosomeConstructor
@@ -33,12 +30,12 @@ type MutatorIntervalBaseVirtualMInterface interface {
 // Probability is applied to each chromosome
 // By default interval will be equal one third of the chromosome
 func NewMutatorIntervalBase(virtual MutatorIntervalBaseVirtualMInterface, probability float64, chromosomeConstructor EmptyChromosomeConstructor) *MutatorIntervalBase {
+	mutator := new(MutatorIntervalBase)
+
 	if probability > 1 || probability < 0 {
-		panic(fmt.Sprintf("Incorrect probability %v", probability))
+		mutator.invalid("Mutator", "Incorrect probability %v", probability)
 	}
 
-	mutator := new(MutatorIntervalBase)
-
 	mutator.MutatorIntervalBaseVirtualMInterface = virtual
 	mutator.probability = probability
 	mutator.chromosomeConstructor = chromosomeConstructor
@@ -52,7 +49,8 @@ func NewMutatorIntervalBase(virtual MutatorIntervalBaseVirtualMInterface, probab
 // Sets interval len to be randomly choosen from [from:to]
 func (mutator *MutatorIntervalBase) ExactInterval(from, to int) *MutatorIntervalBase {
 	if from > to || from < 0 || to < 1 {
-		panic(fmt.Sprintf("Incorrect interval [%d:%d]", from, to))
+		mutator.invalid("Mutator", "Incorrect interval [%d:%d]", from, to)
+		return mutator
 	}
 
 	mutator.kind = mutatorInvertExactLen
@@ -65,7 +63,8 @@ func (mutator *MutatorIntervalBase) ExactInterval(from, to int) *MutatorInterval
 // Sets interval len to be randomly choosen from [from*chromLen : to*chromLen]
 func (mutator *MutatorIntervalBase) PercentageInterval(from, to float64) *MutatorIntervalBase {
 	if from > to || from < 0 || to < 0 || from > 1 || to > 1 {
-		panic(fmt.Sprintf("Incorrect percentage interval [%v:%v]", from, to))
+		mutator.invalid("Mutator", "Incorrect percentage interval [%v:%v]", from, to)
+		return mutator
 	}
 
 	mutator.kind = mutatorInvertPercentageLen
@@ -77,7 +76,7 @@ func (mutator *MutatorIntervalBase) PercentageInterval(from, to float64) *Mutato
 
 func (mutator *MutatorIntervalBase) Mutate(population Chromosomes) {
 	for _, chrom := range population {
-		if mutator.probability < rand.Float64() {
+		if mutator.probability < mutator.randFloat64() {
 			continue
 		}
 
@@ -90,22 +89,23 @@ func (mutator *MutatorIntervalBase) Mutate(population Chromosomes) {
 		from, to := mutator.getInterval(genesLen, intervalLen)
 
 		mutator.MutateGenes(chrom.Genes(), from, to)
+		MarkDirty(chrom)
 	}
 }
 func (mutator *MutatorIntervalBase) getIntervalLen(genesLen int) int {
 	if mutator.kind == mutatorInvertExactLen {
-		return rand.Intn(mutator.toExact-mutator.fromExact+1) + mutator.fromExact
+		return mutator.randIntn(mutator.toExact-mutator.fromExact+1) + mutator.fromExact
 	}
 
-	percent := rand.Float64()*(mutator.toPercent-mutator.fromPercent) + mutator.fromPercent
+	percent := mutator.randFloat64()*(mutator.toPercent-mutator.fromPercent) + mutator.fromPercent
 	return round(percent * float64(genesLen))
 }
 func (mutator *MutatorIntervalBase) getInterval(genesLen, intervalLen int) (int, int) {
 	if intervalLen > genesLen {
-		panic(fmt.Sprintf("Interval bigger than chromosome. %d > %d", intervalLen, genesLen))
+		panic(newConfigError("Mutator", "Interval bigger than chromosome. %d > %d", intervalLen, genesLen))
 	}
 
-	firstPoint := rand.Intn(genesLen - intervalLen + 1)
+	firstPoint := mutator.randIntn(genesLen - intervalLen + 1)
 	return firstPoint, firstPoint + intervalLen
 }
 func (mutator *MutatorIntervalBase) getIntervalCopy(genes GenesInterface, from, to int) GenesInterface {
@@ -1,5 +1,9 @@
 package genetic_algorithm
 
+import (
+	"math/rand"
+)
+
 // InvertMutator + DisplacementMutator
 // Mutator selects some part of the chromosome inverts it then place at other position
 type InvertDisplacementMutator struct {
@@ -19,6 +23,11 @@ func NewInvertDisplacementMutator(probability float64, chromosomeConstructor Emp
 	return mutator
 }
 
+func (mutator *InvertDisplacementMutator) SetRand(rnd *rand.Rand) {
+	mutator.MutatorIntervalBase.SetRand(rnd)
+	mutator.displacer.SetRand(rnd)
+}
+
 func (mutator *InvertDisplacementMutator) MutateGenes(genes GenesInterface, from, to int) {
 	mutator.inverter.MutateGenes(genes, from, to)
 	mutator.displacer.MutateGenes(genes, from, to)
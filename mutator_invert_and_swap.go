@@ -8,6 +8,7 @@ import (
 // Mutator selects some part of the chromosome inverts it then swap on gene from it with one gene outside of the interval
 type InvertSwapMutator struct {
 	*MutatorIntervalBase
+	randomizer
 	inverter *InvertMutator
 }
 
@@ -21,6 +22,11 @@ func NewInvertSwapMutator(probability float64, chromosomeConstructor EmptyChromo
 	return mutator
 }
 
+func (mutator *InvertSwapMutator) SetRand(rnd *rand.Rand) {
+	mutator.MutatorIntervalBase.SetRand(rnd)
+	mutator.randomizer.SetRand(rnd)
+}
+
 func (mutator *InvertSwapMutator) MutateGenes(genes GenesInterface, from, to int) {
 	mutator.inverter.MutateGenes(genes, from, to)
 	mutator.swap(genes, from, to)
@@ -31,10 +37,10 @@ func (mutator *InvertSwapMutator) swap(genes GenesInterface, from, to int) {
 	genes.Swap(ind1, ind2)
 }
 func (mutator *InvertSwapMutator) chooseFirstInd(genesLen, from, to int) int {
-	return rand.Intn(to - from) + from
+	return mutator.randIntn(to - from) + from
 }
 func (mutator *InvertSwapMutator) chooseSecondInd(genesLen, from, to int) int {
-	ind2 := rand.Intn(genesLen - from)
+	ind2 := mutator.randIntn(genesLen - from)
 	if ind2 >= from {
 		ind2 += from
 	}
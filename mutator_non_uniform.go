@@ -0,0 +1,67 @@
+package genetic_algorithm
+
+import (
+	"math"
+)
+
+// Mutator for real chromosomes
+// Moves the gene towards one of its bounds. The step is big at the beginning of optimization
+// and shrinks to zero as generation number approaches maxGenerations.
+// The bigger shape the faster the step shrinks.
+//
+// Genetic Algorithms + Data Structures = Evolution Programs. Zbigniew Michalewicz (1992)
+type NonUniformMutator struct {
+	randomizer
+
+	maxGenerations int
+	shape          float64
+
+	generation int
+}
+
+// Probability is applied to each element separately.
+// Shape=5 is the common choice.
+func NewNonUniformMutator(probability float64, maxGenerations int, shape float64) *MutatorGeneBase {
+	nonUniform := new(NonUniformMutator)
+	nonUniform.maxGenerations = maxGenerations
+	nonUniform.shape = shape
+
+	mutator := NewGeneBaseMutator(nonUniform, probability)
+
+	if maxGenerations <= 0 {
+		mutator.invalid("NonUniformMutator", "maxGenerations must be positive")
+	}
+	if shape < 0 {
+		mutator.invalid("NonUniformMutator", "shape can't be negative")
+	}
+
+	return mutator
+}
+func (mutator *NonUniformMutator) SetGeneration(generation int) {
+	mutator.generation = generation
+}
+func (mutator *NonUniformMutator) MutateCromosome(chrom ChromosomeInterface, ind int) {
+	rc, ok := chrom.(*RealChromosome)
+	if !ok {
+		panic(newConfigError("NonUniformMutator", "Expected RealChromosome, got %T", chrom))
+	}
+
+	val := rc.genes[ind]
+	if mutator.randIntn(2) == 0 {
+		val += mutator.delta(rc.bounds.Upper(ind)-val, mutator.randFloat64())
+	} else {
+		val -= mutator.delta(val-rc.bounds.Lower(ind), mutator.randFloat64())
+	}
+
+	rc.genes[ind] = rc.bounds.Clamp(ind, val)
+}
+
+// Returns value in [0:y] that tends to zero as generation grows
+func (mutator *NonUniformMutator) delta(y, r float64) float64 {
+	progress := float64(mutator.generation) / float64(mutator.maxGenerations)
+	if progress > 1 {
+		progress = 1
+	}
+
+	return y * (1 - math.Pow(r, math.Pow(1-progress, mutator.shape)))
+}
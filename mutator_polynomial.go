@@ -0,0 +1,62 @@
+package genetic_algorithm
+
+import (
+	"math"
+)
+
+// Mutator for real chromosomes
+// Perturbs the gene with polynomial probability distribution, the bigger distribution index
+// the closer mutated value is to the original one.
+// Mutated value never leaves the gene bounds.
+//
+// A Combined Genetic Adaptive Search (GeneAS) for Engineering Design. Kalyanmoy Deb, Mayank Goyal (1996)
+type PolynomialMutator struct {
+	randomizer
+
+	distributionIndex float64
+}
+
+// Probability is applied to each element separately.
+// Typical values of distribution index are in [20:100]
+func NewPolynomialMutator(probability, distributionIndex float64) *MutatorGeneBase {
+	polynomial := new(PolynomialMutator)
+	polynomial.distributionIndex = distributionIndex
+
+	mutator := NewGeneBaseMutator(polynomial, probability)
+
+	if distributionIndex < 0 {
+		mutator.invalid("PolynomialMutator", "distributionIndex can't be negative")
+	}
+
+	return mutator
+}
+func (mutator *PolynomialMutator) MutateCromosome(chrom ChromosomeInterface, ind int) {
+	rc, ok := chrom.(*RealChromosome)
+	if !ok {
+		panic(newConfigError("PolynomialMutator", "Expected RealChromosome, got %T", chrom))
+	}
+
+	geneRange := rc.bounds.Range(ind)
+	if geneRange == 0 {
+		return
+	}
+
+	deltaq := mutator.deltaq(mutator.randFloat64(),
+		(rc.genes[ind]-rc.bounds.Lower(ind))/geneRange,
+		(rc.bounds.Upper(ind)-rc.genes[ind])/geneRange)
+
+	rc.genes[ind] = rc.bounds.Clamp(ind, rc.genes[ind]+deltaq*geneRange)
+}
+
+// delta1 and delta2 are normalized distances to the lower and upper bounds
+func (mutator *PolynomialMutator) deltaq(u, delta1, delta2 float64) float64 {
+	power := 1 / (mutator.distributionIndex + 1)
+
+	if u < 0.5 {
+		val := 2*u + (1-2*u)*math.Pow(1-delta1, mutator.distributionIndex+1)
+		return math.Pow(val, power) - 1
+	}
+
+	val := 2*(1-u) + 2*(u-0.5)*math.Pow(1-delta2, mutator.distributionIndex+1)
+	return 1 - math.Pow(val, power)
+}
@@ -1,11 +1,8 @@
 package genetic_algorithm
 
-import (
-	"math/rand"
-)
-
 // Mutator simply swaps two elements
 type SwapMutator struct {
+	randomizer
 }
 
 // Probability is applied to each element separately.
@@ -19,7 +16,7 @@ func (mutator *SwapMutator) MutateCromosome(chrom ChromosomeInterface, ind int)
 	chrom.Genes().Swap(ind, ind2)
 }
 func (mutator *SwapMutator) chooseSecondInd(genesLen, ind int) int {
-	ind2 := rand.Intn(genesLen - 1)
+	ind2 := mutator.randIntn(genesLen - 1)
 	if ind2 >= ind {
 		ind2++
 	}
@@ -0,0 +1,22 @@
+package genetic_algorithm
+
+// Mutator for real chromosomes
+// Replaces the gene with value uniformly distributed within the gene bounds.
+type UniformResetMutator struct {
+	randomizer
+}
+
+// Probability is applied to each element separately.
+func NewUniformResetMutator(probability float64) *MutatorGeneBase {
+	mutator := NewGeneBaseMutator(new(UniformResetMutator), probability)
+
+	return mutator
+}
+func (mutator *UniformResetMutator) MutateCromosome(chrom ChromosomeInterface, ind int) {
+	rc, ok := chrom.(*RealChromosome)
+	if !ok {
+		panic(newConfigError("UniformResetMutator", "Expected RealChromosome, got %T", chrom))
+	}
+
+	rc.genes[ind] = rc.bounds.Lower(ind) + mutator.randFloat64()*rc.bounds.Range(ind)
+}
@@ -0,0 +1,49 @@
+package genetic_algorithm
+
+// Observes optimization progress.
+// Observers are called from the optimization goroutine, long running work should be done elsewhere.
+type ObserverInterface interface {
+	// Called before initial population is created
+	OnStart()
+	// Called on each generation with sorted population
+	// Population must not be modified
+	OnGeneration(generation int, population Chromosomes, statistics StatisticsDataInterface)
+	// Called when cost of the best chromosome decreases and for the first generation
+	OnNewBest(generation int, best ChromosomeInterface)
+	// Called when optimization is finished, err is set when it was interrupted
+	OnEnd(best ChromosomeInterface, statistics StatisticsDataInterface, err error)
+}
+
+// Observer with empty hooks.
+// Embed it to implement only needed hooks.
+type ObserverBase struct{}
+
+func (observer *ObserverBase) OnStart() {}
+func (observer *ObserverBase) OnGeneration(generation int, population Chromosomes, statistics StatisticsDataInterface) {
+}
+func (observer *ObserverBase) OnNewBest(generation int, best ChromosomeInterface) {}
+func (observer *ObserverBase) OnEnd(best ChromosomeInterface, statistics StatisticsDataInterface, err error) {
+}
+
+type observers []ObserverInterface
+
+func (o observers) onStart() {
+	for _, observer := range o {
+		observer.OnStart()
+	}
+}
+func (o observers) onGeneration(generation int, population Chromosomes, statistics StatisticsDataInterface) {
+	for _, observer := range o {
+		observer.OnGeneration(generation, population, statistics)
+	}
+}
+func (o observers) onNewBest(generation int, best ChromosomeInterface) {
+	for _, observer := range o {
+		observer.OnNewBest(generation, best)
+	}
+}
+func (o observers) onEnd(best ChromosomeInterface, statistics StatisticsDataInterface, err error) {
+	for _, observer := range o {
+		observer.OnEnd(best, statistics, err)
+	}
+}
@@ -1,6 +1,7 @@
 package genetic_algorithm
 
 import (
+	"context"
 	"math/rand"
 	"time"
 )
@@ -14,6 +15,24 @@ func init() {
 type OptimizerInterface interface {
 	Optimize() (ChromosomeInterface, StatisticsDataInterface)
 }
+
+// Optimizers that can be cancelled and report configuration errors instead of panics
+type OptimizerWithContextInterface interface {
+	OptimizerInterface
+	OptimizeContext(ctx context.Context) (ChromosomeInterface, StatisticsDataInterface, error)
+}
+
+// Optimizers which generator can be reseeded, e.g. to run several configurations with the same seeds
+type OptimizerWithSeedInterface interface {
+	OptimizerInterface
+	SetSeed(seed int64)
+}
 type OptimizerWithStatisticsOptionsSetup interface {
 	SetupStatisticsOptions() StatisticsOptionsInterface
 }
+
+// Operators which behavior depends on the generation number.
+// Optimizer sets the number before each iteration.
+type GenerationAwareInterface interface {
+	SetGeneration(generation int)
+}
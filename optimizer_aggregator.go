@@ -1,12 +1,28 @@
 package genetic_algorithm
 
-import ()
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+)
+
+// Creates optimizer for the run with the given index.
+// Each call must return new optimizer with its own operators, since operators keep state.
+// Optimizers are created right before their runs, concurrently if runs are parallel.
+// Runs aren't reproducible unless the aggregator's Seed or Seeds are set.
+type OptimizerConstructor func(run int) OptimizerInterface
 
 type OptimizerAggregator struct {
 	optimizer                       OptimizerInterface
+	optimizerConstructor            OptimizerConstructor
 	statisticsAggregatorConstructor StatisticsAggregatorConstructor
 	statisticsOptions               StatisticsOptionsInterface
 	iterations                      int
+	parallelism                     int
+	seeds                           []int64
+	seed                            int64
+	hasSeed                         bool
 }
 
 func NewOptimizerAggregator() *OptimizerAggregator {
@@ -14,14 +30,24 @@ func NewOptimizerAggregator() *OptimizerAggregator {
 
 	aggregator.statisticsAggregatorConstructor = NewStatisticsDefaultAggregator
 	aggregator.statisticsOptions = NewStatisticsDefaultOptions()
+	aggregator.parallelism = 1
 
 	return aggregator
 }
 
+// Optimizer used for all runs. Runs are made one after another.
 func (aggregator *OptimizerAggregator) Optimizer(optimizer OptimizerInterface) *OptimizerAggregator {
 	aggregator.optimizer = optimizer
 	return aggregator
 }
+
+// Factory of optimizers, new optimizer is created for each run.
+// Optimizers which implement OptimizerWithSeedInterface are seeded with different seeds,
+// so runs have independent generators.
+func (aggregator *OptimizerAggregator) OptimizerConstructor(constr OptimizerConstructor) *OptimizerAggregator {
+	aggregator.optimizerConstructor = constr
+	return aggregator
+}
 func (aggregator *OptimizerAggregator) StatisticsAggregatorConstructor(constr StatisticsAggregatorConstructor) *OptimizerAggregator {
 	aggregator.statisticsAggregatorConstructor = constr
 	return aggregator
@@ -35,50 +61,240 @@ func (aggregator *OptimizerAggregator) Iterations(iterations int) *OptimizerAggr
 	return aggregator
 }
 
-func (aggregator *OptimizerAggregator) check() {
-	if aggregator.optimizer == nil {
-		panic("Optimizer must be set")
+// Number of runs made concurrently. By default 1.
+// Values greater than 1 require OptimizerConstructor, the constructor and cost functions must be safe for concurrent use.
+// Statistics of runs are aggregated in order of runs regardless of the order of their completion.
+func (aggregator *OptimizerAggregator) Parallelism(parallelism int) *OptimizerAggregator {
+	aggregator.parallelism = parallelism
+	return aggregator
+}
+
+// Seeds the optimizer with the i-th value before the i-th run.
+// Optimizer must implement OptimizerWithSeedInterface, there must be a seed for each iteration.
+func (aggregator *OptimizerAggregator) Seeds(seeds ...int64) *OptimizerAggregator {
+	aggregator.seeds = seeds
+	return aggregator
+}
+
+// Seeds of runs are generated from the value, so the set of runs is reproducible.
+// Optimizer must implement OptimizerWithSeedInterface. Ignored if Seeds are set.
+// By default seeds of optimizers created by OptimizerConstructor are generated from the global math/rand source.
+func (aggregator *OptimizerAggregator) Seed(seed int64) *OptimizerAggregator {
+	aggregator.seed = seed
+	aggregator.hasSeed = true
+	return aggregator
+}
+
+func (aggregator *OptimizerAggregator) check() error {
+	if aggregator.optimizer == nil && aggregator.optimizerConstructor == nil {
+		return newConfigError("OptimizerAggregator", "Optimizer or OptimizerConstructor must be set")
+	}
+	if aggregator.optimizer != nil && aggregator.optimizerConstructor != nil {
+		return newConfigError("OptimizerAggregator", "Only one of Optimizer and OptimizerConstructor can be set")
 	}
 	if aggregator.statisticsAggregatorConstructor == nil {
-		panic("StatisticsAggregatorConstructor must be set")
+		return newConfigError("OptimizerAggregator", "StatisticsAggregatorConstructor must be set")
 	}
 	if aggregator.statisticsOptions == nil {
-		panic("StatisticsOptions must be set")
+		return newConfigError("OptimizerAggregator", "StatisticsOptions must be set")
 	}
 	if aggregator.iterations <= 0 {
-		panic("Iterations must be positive value")
+		return newConfigError("OptimizerAggregator", "Iterations must be positive value")
+	}
+	if aggregator.parallelism <= 0 {
+		return newConfigError("OptimizerAggregator", "Parallelism must be positive value")
+	}
+	if aggregator.parallelism > 1 && aggregator.optimizerConstructor == nil {
+		return newConfigError("OptimizerAggregator", "Parallel runs require OptimizerConstructor, runs can't share one optimizer")
+	}
+	if aggregator.seeds != nil && len(aggregator.seeds) < aggregator.iterations {
+		return newConfigError("OptimizerAggregator", "Expected seed for each of %d iterations, got %d", aggregator.iterations, len(aggregator.seeds))
 	}
+	if aggregator.optimizer != nil && (aggregator.seeds != nil || aggregator.hasSeed) {
+		if _, ok := aggregator.optimizer.(OptimizerWithSeedInterface); !ok {
+			return newConfigError("OptimizerAggregator", "Optimizer must implement OptimizerWithSeedInterface to use seeds")
+		}
+	}
+	return nil
 }
 
+// Panics on configuration errors, use OptimizeContext to get them as errors
 func (aggregator *OptimizerAggregator) Optimize() (ChromosomeInterface, StatisticsDataInterface) {
-	aggregator.check()
+	best, data, err := aggregator.OptimizeContext(context.Background())
+	if err != nil {
+		panic(err)
+	}
+
+	return best, data
+}
+
+// Result of one run
+type aggregatorRun struct {
+	done       bool
+	best       ChromosomeInterface
+	stats      StatisticsDataInterface
+	err        error
+	panicValue interface{}
+}
+
+// Context is passed to the optimizer if it supports one and is checked between runs.
+// When the context is done the best chromosome found so far and statistics of completed runs
+// are returned along with the context's error.
+func (aggregator *OptimizerAggregator) OptimizeContext(ctx context.Context) (best ChromosomeInterface, data StatisticsDataInterface, err error) {
+	defer recoverConfigError(&err)
+
+	if err = aggregator.check(); err != nil {
+		return nil, nil, err
+	}
 
 	statisticsAggregator := aggregator.statisticsAggregatorConstructor(aggregator.statisticsOptions)
-	aggregator.ensureOptimizerOptions(statisticsAggregator)
+	ensurer := newStatisticsOptionsEnsurer(statisticsAggregator)
+	seeds := aggregator.runSeeds()
+
+	indexes := make([]int, aggregator.iterations)
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	runs := make([]aggregatorRun, aggregator.iterations)
+	var failed int32
+	parallelFor(indexes, aggregator.parallelism, func(i int) {
+		if atomic.LoadInt32(&failed) != 0 || ctx.Err() != nil {
+			return
+		}
+
+		run := &runs[i]
+		aggregator.run(ctx, i, ensurer, seeds, run)
+		if run.err != nil || run.panicValue != nil {
+			atomic.StoreInt32(&failed, 1)
+		}
+	})
 
 	var bestChrom ChromosomeInterface
-	for i := 0; i < aggregator.iterations; i++ {
-		chrom, stats := aggregator.optimizer.Optimize()
-		if bestChrom == nil || bestChrom.Cost() > chrom.Cost() {
-			bestChrom = chrom
+	completed := 0
+	for _, run := range runs {
+		if run.panicValue != nil {
+			panic(run.panicValue)
+		}
+		if !run.done {
+			continue
 		}
+		completed++
 
-		statisticsAggregator.Aggregate(stats)
+		if run.best != nil && (bestChrom == nil || bestChrom.Cost() > run.best.Cost()) {
+			bestChrom = run.best
+		}
+		if run.err != nil {
+			if err == nil {
+				err = run.err
+			}
+			continue
+		}
+
+		statisticsAggregator.Aggregate(run.stats)
+	}
+
+	if _, ok := err.(*ConfigError); ok {
+		return nil, nil, err
+	}
+	if err == nil && completed < len(runs) {
+		err = ctx.Err()
+	}
+
+	return bestChrom, statisticsAggregator.Compute(), err
+}
+
+// Seed of each run, nil if optimizers aren't seeded
+func (aggregator *OptimizerAggregator) runSeeds() []int64 {
+	if aggregator.seeds != nil {
+		return aggregator.seeds
+	}
+	if !aggregator.hasSeed && aggregator.optimizerConstructor == nil {
+		return nil
+	}
+
+	var rnd *rand.Rand
+	if aggregator.hasSeed {
+		rnd = rand.New(NewRandomSource(aggregator.seed))
+	} else {
+		rnd = rand.New(NewRandomSource(rand.Int63()))
+	}
+
+	seeds := make([]int64, aggregator.iterations)
+	for i := range seeds {
+		seeds[i] = rnd.Int63()
+	}
+	return seeds
+}
+
+// Panics are kept in the result to be raised in the caller's goroutine
+func (aggregator *OptimizerAggregator) run(ctx context.Context, i int, ensurer *statisticsOptionsEnsurer, seeds []int64, run *aggregatorRun) {
+	defer func() {
+		if r := recover(); r != nil {
+			run.panicValue = r
+		}
+	}()
+
+	optimizer := aggregator.optimizer
+	if aggregator.optimizerConstructor != nil {
+		optimizer = aggregator.optimizerConstructor(i)
+	}
+	ensurer.ensure(optimizer)
+
+	if seeds != nil {
+		seeded, ok := optimizer.(OptimizerWithSeedInterface)
+		if ok {
+			seeded.SetSeed(seeds[i])
+		} else if aggregator.seeds != nil || aggregator.hasSeed {
+			panic(newConfigError("OptimizerAggregator", "Optimizer must implement OptimizerWithSeedInterface to use seeds"))
+		}
+	}
+
+	run.best, run.stats, run.err = aggregator.optimize(ctx, optimizer)
+	run.done = true
+}
+func (aggregator *OptimizerAggregator) optimize(ctx context.Context, optimizer OptimizerInterface) (ChromosomeInterface, StatisticsDataInterface, error) {
+	if optimizer, ok := optimizer.(OptimizerWithContextInterface); ok {
+		return optimizer.OptimizeContext(ctx)
 	}
 
-	return bestChrom, statisticsAggregator.Compute()
+	chrom, stats := optimizer.Optimize()
+	return chrom, stats, nil
 }
-func (aggregator *OptimizerAggregator) ensureOptimizerOptions(statisticsAggregator StatisticsAggregatorInterface) {
-	optimizerWithStatisticsOptionsSetup, ok := aggregator.optimizer.(OptimizerWithStatisticsOptionsSetup)
-	if !ok {
+
+// Ensures statistics options of runs' optimizers include options of the statistics aggregator.
+// Options may be shared by optimizers of concurrent runs, so each options are ensured once,
+// before the first run which uses them.
+type statisticsOptionsEnsurer struct {
+	mutex   sync.Mutex
+	options StatisticsOptionsInterface
+	ensured map[StatisticsOptionsInterface]bool
+}
+
+func newStatisticsOptionsEnsurer(statisticsAggregator StatisticsAggregatorInterface) *statisticsOptionsEnsurer {
+	ensurer := new(statisticsOptionsEnsurer)
+
+	if statisticsAggregatorWithOptions, ok := statisticsAggregator.(StatisticsAggregatorWithOptions); ok {
+		ensurer.options = statisticsAggregatorWithOptions.Options()
+	}
+	ensurer.ensured = make(map[StatisticsOptionsInterface]bool)
+
+	return ensurer
+}
+func (ensurer *statisticsOptionsEnsurer) ensure(optimizer OptimizerInterface) {
+	optimizerWithStatisticsOptionsSetup, ok := optimizer.(OptimizerWithStatisticsOptionsSetup)
+	if !ok || ensurer.options == nil {
 		return
 	}
 
-	statisticsAggregatorWithOptions, ok := statisticsAggregator.(StatisticsAggregatorWithOptions)
-	if !ok {
+	ensurer.mutex.Lock()
+	defer ensurer.mutex.Unlock()
+
+	options := optimizerWithStatisticsOptionsSetup.SetupStatisticsOptions()
+	if ensurer.ensured[options] {
 		return
 	}
 
-	statisticsAggregatorWithOptions.Options().Ensure(
-		optimizerWithStatisticsOptionsSetup.SetupStatisticsOptions())
+	ensurer.options.Ensure(options)
+	ensurer.ensured[options] = true
 }
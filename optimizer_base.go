@@ -1,7 +1,9 @@
 package genetic_algorithm
 
 import (
-	log "github.com/cihub/seelog"
+	"context"
+	"io"
+	"math/rand"
 	"sort"
 )
 
@@ -13,23 +15,50 @@ type OptimizerBase struct {
 	selector    SelectorInterface
 	crossover   CrossoverInterface
 	mutator     MutatorInterface
+	repair      RepairInterface
 
-	costFunction          CostFunction
-	statisticsConstructor StatisticsConstructor
-	statisticsOptions     StatisticsOptionsInterface
-	stopCriterion         StopCriterionInterface
+	costFunction            CostFunction
+	constrainedCostFunction ConstrainedCostFunction
+	constraintHandler       ConstraintHandlerInterface
+	statisticsConstructor   StatisticsConstructor
+	statisticsOptions       StatisticsOptionsInterface
+	stopCriterion           StopCriterionInterface
 
-	popSize   int
-	chromSize int
+	popSize       int
+	chromSize     int
+	parallelism   int
+	costCacheSize int
 
-	population Chromosomes
-	statistics StatisticsInterface
+	chromosomeSerializer ChromosomeSerializerInterface
+	checkpointInterval   int
+	checkpointWriter     CheckpointWriterFunction
+	resumeFrom           *optimizerCheckpoint
+
+	observers observers
+
+	population       Chromosomes
+	statistics       StatisticsInterface
+	costCache        *CostCache
+	constrainedCosts map[ChromosomeInterface]ConstrainedCost
+	random           randomizer
+	source           *RandomSource
+	log              logging
+	generation       int
+	resumed          bool
+	best             ChromosomeInterface
+	bestConstrained  ConstrainedCost
 }
 
 // MutatorBase's virtual methods
 type OptimizerBaseVirtualMInterface interface {
 	optimizeInner()
-	check()
+	check() error
+}
+
+// Optional virtual method for optimizers that evaluate and order population themselves,
+// e.g. multi-objective ones. Returns population of the current generation.
+type populationRankerVirtualMInterface interface {
+	rankPopulation(population Chromosomes) Chromosomes
 }
 
 func NewOptimizerBase(virtual OptimizerBaseVirtualMInterface) *OptimizerBase {
@@ -38,6 +67,8 @@ func NewOptimizerBase(virtual OptimizerBaseVirtualMInterface) *OptimizerBase {
 	optimizer.OptimizerBaseVirtualMInterface = virtual
 	optimizer.statisticsConstructor = NewStatisticsDefault
 	optimizer.statisticsOptions = NewStatisticsDefaultOptions()
+	optimizer.constraintHandler = NewFeasibilityRules()
+	optimizer.parallelism = 1
 
 	return optimizer
 }
@@ -58,10 +89,32 @@ func (optimizer *OptimizerBase) Mutator(mutator MutatorInterface) *OptimizerBase
 	optimizer.mutator = mutator
 	return optimizer
 }
+
+// Optional, repairs new and changed chromosomes before evaluation
+func (optimizer *OptimizerBase) Repair(repair RepairInterface) *OptimizerBase {
+	optimizer.repair = repair
+	return optimizer
+}
 func (optimizer *OptimizerBase) CostFunction(cost CostFunction) *OptimizerBase {
 	optimizer.costFunction = cost
 	return optimizer
 }
+
+// Used instead of CostFunction for problems with constraints.
+// Cost of chromosomes is set by the constraint handler from objective and violation.
+// The best chromosome is chosen by constrained costs, see ConstrainedCost.Better.
+// Statistics of costs, e.g. min costs, reflect costs set by the handler, which may be comparable
+// only within one generation, see GenerationRelativeCostsInterface.
+func (optimizer *OptimizerBase) ConstrainedCostFunction(cost ConstrainedCostFunction) *OptimizerBase {
+	optimizer.constrainedCostFunction = cost
+	return optimizer
+}
+
+// By default Deb's feasibility rules
+func (optimizer *OptimizerBase) ConstraintHandler(handler ConstraintHandlerInterface) *OptimizerBase {
+	optimizer.constraintHandler = handler
+	return optimizer
+}
 func (optimizer *OptimizerBase) StopCriterion(stopCriterion StopCriterionInterface) *OptimizerBase {
 	optimizer.stopCriterion = stopCriterion
 	return optimizer
@@ -82,69 +135,365 @@ func (optimizer *OptimizerBase) ChromSize(chromSize int) *OptimizerBase {
 	optimizer.chromSize = chromSize
 	return optimizer
 }
-func (optimizer *OptimizerBase) check() {
+
+// Number of goroutines used for cost evaluation. By default 1.
+// Cost function must be safe for concurrent use when value greater than 1.
+func (optimizer *OptimizerBase) Parallelism(parallelism int) *OptimizerBase {
+	optimizer.parallelism = parallelism
+	return optimizer
+}
+
+// Enables cache of costs for last size evaluated genotypes. By default cache is disabled.
+// Cache is created anew for each optimization.
+func (optimizer *OptimizerBase) CostCache(size int) *OptimizerBase {
+	optimizer.costCacheSize = size
+	return optimizer
+}
+
+// Sets generator which will be passed to all operators.
+// Subsequent optimizations continue to use the generator, so the sequence of runs is reproducible.
+// By default operators use global math/rand source.
+// State of the generator set this way isn't saved in checkpoints, use Seed for that.
+func (optimizer *OptimizerBase) Rand(rnd *rand.Rand) *OptimizerBase {
+	optimizer.random.SetRand(rnd)
+	optimizer.source = nil
+	return optimizer
+}
+
+// Sets generator seeded with the value.
+// State of the generator is saved in checkpoints.
+func (optimizer *OptimizerBase) Seed(seed int64) *OptimizerBase {
+	source := NewRandomSource(seed)
+	optimizer.Rand(rand.New(source))
+	optimizer.source = source
+	return optimizer
+}
+
+func (optimizer *OptimizerBase) SetSeed(seed int64) {
+	optimizer.Seed(seed)
+}
+
+// Sets logger which will be passed to all operators, statistics and stop criterion.
+// By default nothing is logged.
+func (optimizer *OptimizerBase) Logger(logger LoggerInterface) *OptimizerBase {
+	optimizer.log.SetLogger(logger)
+	return optimizer
+}
+
+// Adds observer of optimization progress. Several observers are called in order of addition.
+func (optimizer *OptimizerBase) Observer(observer ObserverInterface) *OptimizerBase {
+	optimizer.observers = append(optimizer.observers, observer)
+	return optimizer
+}
+
+// Serializer used to save population in checkpoints.
+// Binary and ordered chromosomes are serialized out of the box.
+func (optimizer *OptimizerBase) ChromosomeSerializer(serializer ChromosomeSerializerInterface) *OptimizerBase {
+	optimizer.chromosomeSerializer = serializer
+	return optimizer
+}
+
+// Saves state of optimization each interval generations.
+// Writer is asked for destination of each checkpoint.
+func (optimizer *OptimizerBase) Checkpoint(interval int, writer CheckpointWriterFunction) *OptimizerBase {
+	optimizer.checkpointInterval = interval
+	optimizer.checkpointWriter = writer
+	return optimizer
+}
+
+// Reads checkpoint, the next Optimize call continues optimization from it.
+// Operators, cost function and stop criterion must be set the same way as in the interrupted run.
+func (optimizer *OptimizerBase) Resume(r io.Reader) error {
+	checkpoint, err := optimizer.readCheckpoint(r)
+	if err != nil {
+		return err
+	}
+
+	optimizer.resumeFrom = checkpoint
+	return nil
+}
+func (optimizer *OptimizerBase) check() error {
 	if optimizer.initializer == nil {
-		panic("Initializer must be set")
+		return newConfigError("Optimizer", "Initializer must be set")
 	}
 	if optimizer.selector == nil {
-		panic("Selector must be set")
+		return newConfigError("Optimizer", "Selector must be set")
 	}
 	if optimizer.crossover == nil {
-		panic("Crossover must be set")
+		return newConfigError("Optimizer", "Crossover must be set")
 	}
 	if optimizer.mutator == nil {
-		panic("Mutator must be set")
+		return newConfigError("Optimizer", "Mutator must be set")
+	}
+	if _, ok := optimizer.OptimizerBaseVirtualMInterface.(populationRankerVirtualMInterface); !ok &&
+		optimizer.costFunction == nil && optimizer.constrainedCostFunction == nil {
+		return newConfigError("Optimizer", "CostFunction must be set")
+	}
+	if optimizer.costFunction != nil && optimizer.constrainedCostFunction != nil {
+		return newConfigError("Optimizer", "CostFunction and ConstrainedCostFunction can't be set both")
 	}
-	if optimizer.costFunction == nil {
-		panic("CostFunction must be set")
+	if optimizer.constrainedCostFunction != nil && optimizer.constraintHandler == nil {
+		return newConfigError("Optimizer", "ConstraintHandler must be set")
+	}
+	if optimizer.constrainedCostFunction != nil && optimizer.costCacheSize > 0 {
+		return newConfigError("Optimizer", "CostCache isn't supported with ConstrainedCostFunction")
 	}
 	if optimizer.stopCriterion == nil {
-		panic("StopCriterion must be set")
+		return newConfigError("Optimizer", "StopCriterion must be set")
+	}
+	if optimizer.relativeCosts() && comparesCosts(optimizer.stopCriterion) {
+		return newConfigError("Optimizer", "StopCriterion compares costs of different generations, which aren't comparable with the constraint handler")
 	}
 	if optimizer.statisticsConstructor == nil {
-		panic("StatisticsConstructor must be set")
+		return newConfigError("Optimizer", "StatisticsConstructor must be set")
 	}
 	if optimizer.statisticsOptions == nil {
-		panic("StatisticsOptions must be set")
+		return newConfigError("Optimizer", "StatisticsOptions must be set")
 	}
 	if optimizer.popSize <= 0 {
-		panic("PopSize must be positive value")
+		return newConfigError("Optimizer", "PopSize must be positive value")
 	}
 	if optimizer.chromSize <= 0 {
-		panic("ChromSize must be positive value")
+		return newConfigError("Optimizer", "ChromSize must be positive value")
+	}
+	if optimizer.parallelism <= 0 {
+		return newConfigError("Optimizer", "Parallelism must be positive value")
+	}
+	if optimizer.costCacheSize < 0 {
+		return newConfigError("Optimizer", "CostCache size can't be negative")
+	}
+	if optimizer.checkpointWriter != nil && optimizer.checkpointInterval <= 0 {
+		return newConfigError("Optimizer", "Checkpoint interval must be positive value")
+	}
+
+	if err := checkAll(optimizer.initializer, optimizer.selector, optimizer.crossover, optimizer.mutator,
+		optimizer.repair, optimizer.constraintHandler, optimizer.stopCriterion); err != nil {
+		return err
+	}
+	if err := checkAllChromSize(optimizer.chromSize, optimizer.initializer, optimizer.crossover, optimizer.mutator); err != nil {
+		return err
 	}
 
-	optimizer.OptimizerBaseVirtualMInterface.check()
+	return optimizer.OptimizerBaseVirtualMInterface.check()
 }
 
+// Panics on configuration errors, use OptimizeContext to get them as errors
 func (optimizer *OptimizerBase) Optimize() (ChromosomeInterface, StatisticsDataInterface) {
-	optimizer.check()
+	best, data, err := optimizer.OptimizeContext(context.Background())
+	if err != nil {
+		panic(err)
+	}
+
+	return best, data
+}
+
+// Optimization stops at the next generation boundary when the context is done.
+// In that case the best chromosome found so far and statistics are returned along with the context's error.
+// Configuration errors are returned as *ConfigError.
+func (optimizer *OptimizerBase) OptimizeContext(ctx context.Context) (ChromosomeInterface, StatisticsDataInterface, error) {
+	if err := optimizer.check(); err != nil {
+		return nil, nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	optimizer.observers.onStart()
+
+	best, data, err := optimizer.optimize(ctx)
+
+	optimizer.observers.onEnd(best, data, err)
+
+	return best, data, err
+}
+func (optimizer *OptimizerBase) optimize(ctx context.Context) (best ChromosomeInterface, data StatisticsDataInterface, err error) {
+	defer recoverConfigError(&err)
+
+	if err = optimizer.begin(); err != nil {
+		return nil, nil, err
+	}
+
+	for !optimizer.evaluateGeneration() {
+		if err = ctx.Err(); err != nil {
+			optimizer.log.infof("Stop by context: %v", err)
+			break
+		}
+
+		optimizer.nextGeneration()
+	}
+
+	optimizer.end()
+
+	return optimizer.best, optimizer.statistics.Data(), err
+}
+
+// Prepares operators and statistics, creates initial population or restores it from checkpoint
+func (optimizer *OptimizerBase) begin() error {
 	optimizer.stopCriterion.Setup(optimizer.statisticsOptions)
 
+	optimizer.setRand()
+
 	optimizer.statistics = optimizer.statisticsConstructor(optimizer.statisticsOptions)
+	optimizer.setLogger()
 	optimizer.statistics.Start()
 
-	optimizer.initPopulation()
-
-	iter := 0
-	for {
-		log.Infof("GENERATION %d", iter)
+	optimizer.constrainedCosts = nil
+	optimizer.costCache = nil
+	if optimizer.costCacheSize > 0 {
+		optimizer.costCache = NewCostCache(optimizer.costFunction, optimizer.costCacheSize)
+	}
 
-		optimizer.sort()
-		optimizer.statistics.OnGeneration(optimizer.population)
+	optimizer.best = nil
+	optimizer.resumed = optimizer.resumeFrom != nil
+	if optimizer.resumed {
+		checkpoint := optimizer.resumeFrom
+		optimizer.resumeFrom = nil
 
-		if optimizer.stopCriterion.ShouldStop(optimizer.statistics.Data()) {
-			break
+		if err := optimizer.restoreCheckpoint(checkpoint); err != nil {
+			return err
 		}
+	} else {
+		optimizer.generation = 0
+		optimizer.initPopulation()
+	}
+
+	return checkGenesDistance(optimizer.statisticsOptions, optimizer.population)
+}
+
+// Evaluates and sorts population of the current generation, updates statistics.
+// Returns true when the stop criterion is met.
+func (optimizer *OptimizerBase) evaluateGeneration() bool {
+	optimizer.log.infof("GENERATION %d", optimizer.generation)
+
+	if !optimizer.resumed {
+		optimizer.checkpoint()
+	}
+	optimizer.resumed = false
+
+	optimizer.sort()
+	optimizer.statistics.OnGeneration(optimizer.population)
+
+	// Copy, so the best chromosome isn't changed by operators in the next generations
+	if best, constrained := optimizer.generationBest(); optimizer.improvesBest(best, constrained) {
+		optimizer.best = copyOf(best)
+		optimizer.bestConstrained = constrained
+		optimizer.observers.onNewBest(optimizer.generation, optimizer.best)
+	}
+	optimizer.observers.onGeneration(optimizer.generation, optimizer.population, optimizer.statistics.Data())
 
-		optimizer.OptimizerBaseVirtualMInterface.optimizeInner()
+	return optimizer.stopCriterion.ShouldStop(optimizer.statistics.Data())
+}
 
-		iter++
+// The first chromosome of the sorted population.
+// With constraints the best one by constrained cost, since handlers may prefer infeasible chromosomes.
+func (optimizer *OptimizerBase) generationBest() (ChromosomeInterface, ConstrainedCost) {
+	best := optimizer.population[0]
+	if optimizer.constrainedCostFunction == nil {
+		return best, ConstrainedCost{}
 	}
 
+	bestConstrained := optimizer.constrainedCosts[best]
+	for _, chrom := range optimizer.population[1:] {
+		if constrained := optimizer.constrainedCosts[chrom]; constrained.Better(bestConstrained) {
+			best, bestConstrained = chrom, constrained
+		}
+	}
+	return best, bestConstrained
+}
+
+// Whether chromosome is better than the best one found so far.
+// With constraints chromosomes of different generations are compared by constrained costs.
+func (optimizer *OptimizerBase) improvesBest(chrom ChromosomeInterface, constrained ConstrainedCost) bool {
+	if optimizer.best == nil {
+		return true
+	}
+	if optimizer.constrainedCostFunction != nil {
+		return constrained.Better(optimizer.bestConstrained)
+	}
+	return chrom.Cost() < optimizer.best.Cost()
+}
+
+// Whether costs of different generations aren't comparable
+func (optimizer *OptimizerBase) relativeCosts() bool {
+	return optimizer.constrainedCostFunction != nil && generationRelativeCosts(optimizer.constraintHandler)
+}
+
+// Breeds population of the next generation
+func (optimizer *OptimizerBase) nextGeneration() {
+	optimizer.setGeneration(optimizer.generation)
+	optimizer.OptimizerBaseVirtualMInterface.optimizeInner()
+
+	optimizer.generation++
+}
+func (optimizer *OptimizerBase) end() {
 	optimizer.statistics.End()
+}
+func (optimizer *OptimizerBase) operators() []interface{} {
+	return []interface{}{
+		optimizer.initializer,
+		optimizer.selector,
+		optimizer.crossover,
+		optimizer.mutator,
+		optimizer.repair,
+		optimizer.constraintHandler,
+		optimizer.OptimizerBaseVirtualMInterface,
+	}
+}
+func (optimizer *OptimizerBase) setRand() {
+	if optimizer.random.rnd == nil {
+		return
+	}
 
-	return optimizer.population[0], optimizer.statistics.Data()
+	for _, operator := range optimizer.operators() {
+		if randomized, ok := operator.(RandomizedInterface); ok {
+			randomized.SetRand(optimizer.random.rnd)
+		}
+	}
+}
+func (optimizer *OptimizerBase) setLogger() {
+	if optimizer.log.logger == nil {
+		return
+	}
+
+	loggables := append(optimizer.operators(), optimizer.statistics, optimizer.stopCriterion)
+	for _, component := range loggables {
+		if loggable, ok := component.(LoggableInterface); ok {
+			loggable.SetLogger(optimizer.log.logger)
+		}
+	}
+}
+func (optimizer *OptimizerBase) setGeneration(generation int) {
+	for _, operator := range optimizer.operators() {
+		if generationAware, ok := operator.(GenerationAwareInterface); ok {
+			generationAware.SetGeneration(generation)
+		}
+	}
+}
+
+// Writes checkpoint if it's time for it.
+// Failed checkpoint doesn't stop optimization.
+func (optimizer *OptimizerBase) checkpoint() {
+	if optimizer.checkpointWriter == nil || optimizer.generation == 0 ||
+		optimizer.generation%optimizer.checkpointInterval != 0 {
+		return
+	}
+
+	optimizer.statistics.Start("checkpoint")
+	defer optimizer.statistics.End()
+
+	w, err := optimizer.checkpointWriter(optimizer.generation)
+	if err == nil {
+		err = optimizer.writeCheckpoint(w)
+		if closer, ok := w.(io.Closer); ok {
+			if closeErr := closer.Close(); err == nil {
+				err = closeErr
+			}
+		}
+	}
+
+	if err != nil {
+		optimizer.log.errorf("Checkpoint of generation %d failed: %v", optimizer.generation, err)
+	}
 }
 func (optimizer *OptimizerBase) initPopulation() {
 	optimizer.statistics.Start("init")
@@ -152,18 +501,135 @@ func (optimizer *OptimizerBase) initPopulation() {
 
 	optimizer.population = optimizer.initializer.Init(optimizer.popSize, optimizer.chromSize)
 	if len(optimizer.population) == 0 {
-		panic("Init population is empty")
+		panic(newConfigError("Optimizer", "Init population is empty"))
 	}
 }
+
+// Evaluates changed chromosomes and sorts population.
+// Population that is kept sorted by optimizer isn't sorted again.
 func (optimizer *OptimizerBase) sort() {
+	if ranker, ok := optimizer.OptimizerBaseVirtualMInterface.(populationRankerVirtualMInterface); ok {
+		optimizer.population = ranker.rankPopulation(optimizer.population)
+	} else {
+		optimizer.evaluate(optimizer.population)
+
+		if !sort.IsSorted(optimizer.population) {
+			sort.Sort(optimizer.population)
+		}
+	}
+	if optimizer.constrainedCostFunction != nil {
+		optimizer.onFeasibility()
+	}
+
+	optimizer.log.infof("Best: %v", optimizer.population[0])
+	if optimizer.log.logEnabled(LogLevelDebug) {
+		optimizer.log.debugf("Population:\n%v", optimizer.population)
+	}
+}
+
+// Sets cost of dirty chromosomes
+func (optimizer *OptimizerBase) evaluate(chroms Chromosomes) {
+	optimizer.repairDirty(chroms)
+
+	if optimizer.constrainedCostFunction != nil {
+		optimizer.evaluateConstrained(chroms)
+		return
+	}
+
+	optimizer.statistics.Start("cost")
+	defer optimizer.statistics.End()
+
+	dirty := chroms.Dirty()
+	if optimizer.costCache == nil {
+		dirty.SetCostParallel(optimizer.costFunction, optimizer.parallelism)
+	} else {
+		hits, misses := optimizer.costCache.Hits(), optimizer.costCache.Misses()
+		dirty.SetCostParallel(optimizer.costCache.Cost, optimizer.parallelism)
+		optimizer.onCostCache(optimizer.costCache.Hits()-hits, optimizer.costCache.Misses()-misses)
+	}
+	optimizer.onEvaluations(len(dirty), len(chroms)-len(dirty))
+}
+
+// Repairs chromosomes which were created or changed since the last evaluation
+func (optimizer *OptimizerBase) repairDirty(chroms Chromosomes) {
+	if optimizer.repair == nil {
+		return
+	}
+
+	optimizer.statistics.Start("repair")
+	defer optimizer.statistics.End()
+
+	for _, chrom := range chroms.Dirty() {
+		optimizer.repair.Repair(chrom)
+	}
+}
+
+// Evaluates constrained costs of dirty chromosomes and ranks them together with population,
+// so costs of population and new chromosomes are comparable. Population is kept sorted.
+func (optimizer *OptimizerBase) evaluateConstrained(chroms Chromosomes) {
 	optimizer.statistics.Start("cost")
 	defer optimizer.statistics.End()
 
-	optimizer.population.SetCost(optimizer.costFunction)
-	sort.Sort(optimizer.population)
+	ranked := make(Chromosomes, 0, len(optimizer.population)+len(chroms))
+	seen := make(map[ChromosomeInterface]bool, cap(ranked))
+	for _, group := range []Chromosomes{optimizer.population, chroms} {
+		for _, chrom := range group {
+			if !seen[chrom] {
+				seen[chrom] = true
+				ranked = append(ranked, chrom)
+			}
+		}
+	}
 
-	log.Infof("Best: %v", optimizer.population[0])
-	log.Debugf("Population:\n%v\n", optimizer.population)
+	// Chromosomes restored from checkpoint don't have constrained costs
+	costs := make([]ConstrainedCost, len(ranked))
+	var dirty []int
+	for i, chrom := range ranked {
+		if cost, ok := optimizer.constrainedCosts[chrom]; ok && !isDirty(chrom) {
+			costs[i] = cost
+		} else {
+			dirty = append(dirty, i)
+		}
+	}
+	parallelFor(dirty, optimizer.parallelism, func(i int) {
+		costs[i] = optimizer.constrainedCostFunction(ranked[i])
+	})
+
+	optimizer.constrainedCosts = make(map[ChromosomeInterface]ConstrainedCost, len(ranked))
+	for i, chrom := range ranked {
+		optimizer.constrainedCosts[chrom] = costs[i]
+	}
+
+	optimizer.constraintHandler.Rank(ranked, costs)
+	if !sort.IsSorted(optimizer.population) {
+		sort.Sort(optimizer.population)
+	}
+
+	optimizer.onEvaluations(len(dirty), len(chroms)-len(dirty))
+}
+func (optimizer *OptimizerBase) onFeasibility() {
+	statistics, ok := optimizer.statistics.(StatisticsWithFeasibilityInterface)
+	if !ok {
+		return
+	}
+
+	feasible := 0
+	for _, chrom := range optimizer.population {
+		if optimizer.constrainedCosts[chrom].Feasible() {
+			feasible++
+		}
+	}
+	statistics.OnFeasibility(feasible, len(optimizer.population))
+}
+func (optimizer *OptimizerBase) onEvaluations(evaluated, skipped int) {
+	if statistics, ok := optimizer.statistics.(StatisticsWithEvaluationsInterface); ok {
+		statistics.OnEvaluations(evaluated, skipped)
+	}
+}
+func (optimizer *OptimizerBase) onCostCache(hits, misses int) {
+	if statistics, ok := optimizer.statistics.(StatisticsWithCostCacheInterface); ok {
+		statistics.OnCostCache(hits, misses)
+	}
 }
 
 func (optimizer *OptimizerBase) SetupStatisticsOptions() StatisticsOptionsInterface {
@@ -1,7 +1,7 @@
 package genetic_algorithm
 
 import (
-	log "github.com/cihub/seelog"
+	"math/rand"
 )
 
 type IncrementalOptimizer struct {
@@ -22,6 +22,20 @@ func (optimizer *IncrementalOptimizer) Weeder(weeder WeederInterface) *Increment
 	return optimizer
 }
 
+// Passes optimizer's generator to the weeder
+func (optimizer *IncrementalOptimizer) SetRand(rnd *rand.Rand) {
+	if randomized, ok := optimizer.weeder.(RandomizedInterface); ok {
+		randomized.SetRand(rnd)
+	}
+}
+
+// Passes optimizer's logger to the weeder
+func (optimizer *IncrementalOptimizer) SetLogger(logger LoggerInterface) {
+	if loggable, ok := optimizer.weeder.(LoggableInterface); ok {
+		loggable.SetLogger(logger)
+	}
+}
+
 func (optimizer *IncrementalOptimizer) optimizeInner() {
 	optimizer.weed()
 	optimizer.breed()
@@ -33,7 +47,9 @@ func (optimizer *IncrementalOptimizer) weed() {
 
 	optimizer.population = optimizer.weeder.Weed(optimizer.population)
 
-	log.Tracef("Weeded population:\n%v\n", optimizer.population)
+	if optimizer.log.logEnabled(LogLevelTrace) {
+		optimizer.log.tracef("Weeded population:\n%v", optimizer.population)
+	}
 }
 func (optimizer *IncrementalOptimizer) breed() {
 	optimizer.statistics.Start("breed")
@@ -45,11 +61,15 @@ func (optimizer *IncrementalOptimizer) breed() {
 
 	for {
 		chromsToCross := optimizer.selector.SelectMany(optimizer.crossover.ParentsCount())
-		log.Debugf("Parents:\n%v", chromsToCross)
+		if optimizer.log.logEnabled(LogLevelDebug) {
+			optimizer.log.debugf("Parents:\n%v", chromsToCross)
+		}
 
 		children := optimizer.crossover.Crossover(chromsToCross)
 
-		log.Debugf("Children\n%v\n", children)
+		if optimizer.log.logEnabled(LogLevelDebug) {
+			optimizer.log.debugf("Children\n%v", children)
+		}
 
 		for i := 0; i < len(children); i++ {
 			newPopulation = append(newPopulation, children[i])
@@ -68,8 +88,9 @@ func (optimizer *IncrementalOptimizer) mutate() {
 	optimizer.mutator.Mutate(optimizer.population)
 }
 
-func (optimizer *IncrementalOptimizer) check() {
+func (optimizer *IncrementalOptimizer) check() error {
 	if optimizer.weeder == nil {
-		panic("Weeder must be set")
+		return newConfigError("IncrementalOptimizer", "Weeder must be set")
 	}
+	return checkAll(optimizer.weeder)
 }
@@ -0,0 +1,462 @@
+package genetic_algorithm
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"sync"
+)
+
+// Optimizers that can be islands of IslandOptimizer.
+// Implemented by all optimizers built on OptimizerBase.
+type IslandInterface interface {
+	OptimizerInterface
+	optimizerBase() *OptimizerBase
+}
+
+func (optimizer *OptimizerBase) optimizerBase() *OptimizerBase {
+	return optimizer
+}
+
+// Island model optimizer.
+// Each island is a separate optimizer with its own population and operators.
+// Islands make generations in parallel goroutines and periodically exchange migrants.
+//
+// Islands are stopped by their own stop criteria, whole optimization is stopped
+// by the stop criterion of the island optimizer or when all islands are stopped.
+// Stopped islands neither send nor receive migrants.
+type IslandOptimizer struct {
+	islands []*OptimizerBase
+
+	topology           TopologyInterface
+	migrationInterval  int
+	migrantCount       int
+	migrantSelector    SelectorInterface
+	replacedSelector   SelectorInterface
+	migrantConstructor EmptyChromosomeConstructor
+
+	statisticsConstructor StatisticsConstructor
+	statisticsOptions     StatisticsOptionsInterface
+	stopCriterion         StopCriterionInterface
+
+	statistics       StatisticsInterface
+	islandStatistics []StatisticsDataInterface
+	stopped          []bool
+	evaluations      []evaluationsCounter
+	random           randomizer
+}
+
+func NewIslandOptimizer() *IslandOptimizer {
+	optimizer := new(IslandOptimizer)
+
+	optimizer.topology = NewRingTopology()
+	optimizer.migrationInterval = 10
+	optimizer.migrantCount = 1
+	optimizer.migrantSelector = NewSimpleTournamentSelector(2)
+	optimizer.statisticsConstructor = NewStatisticsDefault
+	optimizer.statisticsOptions = NewStatisticsDefaultOptions()
+
+	return optimizer
+}
+
+// Adds island. Islands must not be shared with other optimizers.
+func (optimizer *IslandOptimizer) Island(island IslandInterface) *IslandOptimizer {
+	optimizer.islands = append(optimizer.islands, island.optimizerBase())
+	return optimizer
+}
+
+// By default ring
+func (optimizer *IslandOptimizer) Topology(topology TopologyInterface) *IslandOptimizer {
+	optimizer.topology = topology
+	return optimizer
+}
+
+// Number of generations between migrations. By default 10
+func (optimizer *IslandOptimizer) MigrationInterval(interval int) *IslandOptimizer {
+	optimizer.migrationInterval = interval
+	return optimizer
+}
+
+// Number of migrants each island sends to each neighbour. By default 1
+func (optimizer *IslandOptimizer) MigrantCount(count int) *IslandOptimizer {
+	optimizer.migrantCount = count
+	return optimizer
+}
+
+// Chooses migrants in the sending island. By default tournament of 2
+func (optimizer *IslandOptimizer) MigrantSelector(selector SelectorInterface) *IslandOptimizer {
+	optimizer.migrantSelector = selector
+	return optimizer
+}
+
+// Chooses chromosomes replaced by migrants in the receiving island, e.g. NewRandomSelector().
+// The best chromosome of the island is never replaced. By default the worst chromosomes are replaced.
+func (optimizer *IslandOptimizer) ReplacedSelector(selector SelectorInterface) *IslandOptimizer {
+	optimizer.replacedSelector = selector
+	return optimizer
+}
+
+// Constructor used to copy migrants, islands don't share chromosomes
+func (optimizer *IslandOptimizer) MigrantConstructor(constr EmptyChromosomeConstructor) *IslandOptimizer {
+	optimizer.migrantConstructor = constr
+	return optimizer
+}
+func (optimizer *IslandOptimizer) StatisticsConstructor(constr StatisticsConstructor) *IslandOptimizer {
+	optimizer.statisticsConstructor = constr
+	return optimizer
+}
+func (optimizer *IslandOptimizer) StatisticsOptions(statisticsOptions StatisticsOptionsInterface) *IslandOptimizer {
+	optimizer.statisticsOptions = statisticsOptions
+	return optimizer
+}
+
+// Optional, by default optimization lasts until all islands are stopped
+func (optimizer *IslandOptimizer) StopCriterion(stopCriterion StopCriterionInterface) *IslandOptimizer {
+	optimizer.stopCriterion = stopCriterion
+	return optimizer
+}
+
+// Sets generator used for migration. Islands use their own generators.
+func (optimizer *IslandOptimizer) Rand(rnd *rand.Rand) *IslandOptimizer {
+	optimizer.random.SetRand(rnd)
+	return optimizer
+}
+func (optimizer *IslandOptimizer) Seed(seed int64) *IslandOptimizer {
+	return optimizer.Rand(rand.New(NewRandomSource(seed)))
+}
+
+// Seeds migration with the value and islands with the following values
+func (optimizer *IslandOptimizer) SetSeed(seed int64) {
+	optimizer.Seed(seed)
+	for i, island := range optimizer.islands {
+		island.Seed(seed + int64(i) + 1)
+	}
+}
+
+// Statistics of each island of the last optimization
+func (optimizer *IslandOptimizer) IslandStatistics() []StatisticsDataInterface {
+	return optimizer.islandStatistics
+}
+
+func (optimizer *IslandOptimizer) SetupStatisticsOptions() StatisticsOptionsInterface {
+	return optimizer.statisticsOptions
+}
+
+func (optimizer *IslandOptimizer) check() error {
+	if len(optimizer.islands) == 0 {
+		return newConfigError("IslandOptimizer", "At least one island must be set")
+	}
+	if optimizer.topology == nil {
+		return newConfigError("IslandOptimizer", "Topology must be set")
+	}
+	if optimizer.migrationInterval <= 0 {
+		return newConfigError("IslandOptimizer", "MigrationInterval must be positive value")
+	}
+	if optimizer.migrantCount < 0 {
+		return newConfigError("IslandOptimizer", "MigrantCount can't be negative")
+	}
+	if optimizer.migrantSelector == nil {
+		return newConfigError("IslandOptimizer", "MigrantSelector must be set")
+	}
+	if optimizer.migrantCount > 0 && len(optimizer.islands) > 1 && optimizer.migrantConstructor == nil {
+		return newConfigError("IslandOptimizer", "MigrantConstructor must be set")
+	}
+	if optimizer.statisticsConstructor == nil {
+		return newConfigError("IslandOptimizer", "StatisticsConstructor must be set")
+	}
+	if optimizer.statisticsOptions == nil {
+		return newConfigError("IslandOptimizer", "StatisticsOptions must be set")
+	}
+
+	seen := make(map[*OptimizerBase]bool, len(optimizer.islands))
+	for _, island := range optimizer.islands {
+		if seen[island] {
+			return newConfigError("IslandOptimizer", "The same island is added twice")
+		}
+		seen[island] = true
+
+		if err := island.check(); err != nil {
+			return err
+		}
+		if island.relativeCosts() && comparesCosts(optimizer.stopCriterion) {
+			return newConfigError("IslandOptimizer", "StopCriterion compares costs of different generations, which aren't comparable with constraint handlers of islands")
+		}
+	}
+
+	return checkAll(optimizer.topology, optimizer.migrantSelector, optimizer.replacedSelector, optimizer.stopCriterion)
+}
+
+// Panics on configuration errors, use OptimizeContext to get them as errors
+func (optimizer *IslandOptimizer) Optimize() (ChromosomeInterface, StatisticsDataInterface) {
+	best, data, err := optimizer.OptimizeContext(context.Background())
+	if err != nil {
+		panic(err)
+	}
+
+	return best, data
+}
+
+// Returns the best chromosome of all islands and combined statistics, which are gathered
+// on union of islands populations. Statistics of separate islands are returned by IslandStatistics.
+func (optimizer *IslandOptimizer) OptimizeContext(ctx context.Context) (best ChromosomeInterface, data StatisticsDataInterface, err error) {
+	if err = optimizer.check(); err != nil {
+		return nil, nil, err
+	}
+	if err = ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	defer recoverConfigError(&err)
+
+	if optimizer.stopCriterion != nil {
+		optimizer.stopCriterion.Setup(optimizer.statisticsOptions)
+	}
+	optimizer.setRand()
+
+	optimizer.statistics = optimizer.statisticsConstructor(optimizer.statisticsOptions)
+	optimizer.statistics.Start()
+
+	optimizer.stopped = make([]bool, len(optimizer.islands))
+	optimizer.evaluations = make([]evaluationsCounter, len(optimizer.islands))
+
+	for _, island := range optimizer.islands {
+		island.observers.onStart()
+	}
+
+	if err = optimizer.parallel(func(i int, island *OptimizerBase) error {
+		return island.begin()
+	}); err != nil {
+		return nil, nil, err
+	}
+	if err = checkGenesDistance(optimizer.statisticsOptions, optimizer.islands[0].population); err != nil {
+		return nil, nil, err
+	}
+
+	for generation := 0; ; generation++ {
+		if err = optimizer.parallel(func(i int, island *OptimizerBase) error {
+			if !optimizer.stopped[i] {
+				optimizer.stopped[i] = island.evaluateGeneration()
+			}
+			return nil
+		}); err != nil {
+			return nil, nil, err
+		}
+
+		population := optimizer.combinedPopulation()
+		optimizer.onEvaluations()
+		optimizer.statistics.OnGeneration(population)
+
+		if optimizer.allStopped() ||
+			optimizer.stopCriterion != nil && optimizer.stopCriterion.ShouldStop(optimizer.statistics.Data()) {
+			break
+		}
+		if err = ctx.Err(); err != nil {
+			break
+		}
+
+		if (generation+1)%optimizer.migrationInterval == 0 {
+			optimizer.migrate()
+		}
+
+		if err = optimizer.parallel(func(i int, island *OptimizerBase) error {
+			if !optimizer.stopped[i] {
+				island.nextGeneration()
+			}
+			return nil
+		}); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	optimizer.islandStatistics = make([]StatisticsDataInterface, len(optimizer.islands))
+	for i, island := range optimizer.islands {
+		island.end()
+		optimizer.islandStatistics[i] = island.statistics.Data()
+		island.observers.onEnd(island.best, optimizer.islandStatistics[i], err)
+	}
+	optimizer.statistics.End()
+
+	return optimizer.best(), optimizer.statistics.Data(), err
+}
+
+// Runs function for each island in separate goroutine and returns the first error.
+// Configuration errors raised as panics are returned as errors.
+func (optimizer *IslandOptimizer) parallel(fn func(i int, island *OptimizerBase) error) error {
+	errs := make([]error, len(optimizer.islands))
+
+	var wg sync.WaitGroup
+	for i, island := range optimizer.islands {
+		wg.Add(1)
+		go func(i int, island *OptimizerBase) {
+			defer wg.Done()
+			defer recoverConfigError(&errs[i])
+
+			errs[i] = fn(i, island)
+		}(i, island)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (optimizer *IslandOptimizer) allStopped() bool {
+	for _, stopped := range optimizer.stopped {
+		if !stopped {
+			return false
+		}
+	}
+	return true
+}
+func (optimizer *IslandOptimizer) setRand() {
+	if optimizer.random.rnd == nil {
+		return
+	}
+
+	for _, component := range []interface{}{optimizer.topology, optimizer.migrantSelector, optimizer.replacedSelector} {
+		if randomized, ok := component.(RandomizedInterface); ok {
+			randomized.SetRand(optimizer.random.rnd)
+		}
+	}
+}
+
+// Sorted union of islands populations
+func (optimizer *IslandOptimizer) combinedPopulation() Chromosomes {
+	size := 0
+	for _, island := range optimizer.islands {
+		size += len(island.population)
+	}
+
+	population := make(Chromosomes, 0, size)
+	for _, island := range optimizer.islands {
+		population = append(population, island.population...)
+	}
+	sort.Sort(population)
+
+	return population
+}
+func (optimizer *IslandOptimizer) best() ChromosomeInterface {
+	var best *OptimizerBase
+	for _, island := range optimizer.islands {
+		if best == nil || best.improvesBest(island.best, island.bestConstrained) {
+			best = island
+		}
+	}
+	return best.best
+}
+
+// Passes sum of islands evaluations to the combined statistics
+func (optimizer *IslandOptimizer) onEvaluations() {
+	statistics, ok := optimizer.statistics.(StatisticsWithEvaluationsInterface)
+	if !ok {
+		return
+	}
+
+	evaluated, skipped := 0, 0
+	for i, island := range optimizer.islands {
+		e, s := optimizer.evaluations[i].delta(island.statistics.Data())
+		evaluated += e
+		skipped += s
+	}
+	statistics.OnEvaluations(evaluated, skipped)
+}
+
+// Each island sends copies of migrants to its neighbours.
+// All migrants are chosen before any of them is placed, so migrants don't travel further than one island.
+func (optimizer *IslandOptimizer) migrate() {
+	if optimizer.migrantCount == 0 {
+		return
+	}
+
+	migrants := make([]Chromosomes, len(optimizer.islands))
+	for i, island := range optimizer.islands {
+		if optimizer.stopped[i] {
+			continue
+		}
+
+		count := optimizer.migrantCount
+		if count > len(island.population) {
+			count = len(island.population)
+		}
+
+		optimizer.migrantSelector.Prepare(island.population)
+		migrants[i] = optimizer.migrantSelector.SelectMany(count)
+	}
+
+	for i := range optimizer.islands {
+		if migrants[i] == nil {
+			continue
+		}
+
+		for _, neighbour := range optimizer.topology.Neighbours(i, len(optimizer.islands)) {
+			if optimizer.stopped[neighbour] {
+				continue
+			}
+
+			optimizer.immigrate(optimizer.islands[neighbour], migrants[i])
+		}
+	}
+}
+func (optimizer *IslandOptimizer) immigrate(island *OptimizerBase, migrants Chromosomes) {
+	population := island.population
+
+	// The best chromosome is never replaced
+	count := len(migrants)
+	if count > len(population)-1 {
+		count = len(population) - 1
+	}
+
+	var replaced []int
+	if optimizer.replacedSelector == nil {
+		replaced = make([]int, count)
+		for i := 0; i < count; i++ {
+			replaced[i] = len(population) - 1 - i
+		}
+	} else {
+		candidates := population[1:]
+		optimizer.replacedSelector.Prepare(candidates)
+		for _, chrom := range optimizer.replacedSelector.SelectMany(count) {
+			replaced = append(replaced, candidates.index(chrom)+1)
+		}
+	}
+
+	for i, ind := range replaced {
+		population[ind] = copyChromosome(optimizer.migrantConstructor, migrants[i])
+	}
+	sort.Sort(population)
+}
+
+// Copies genes and cost of the chromosome
+func copyChromosome(constr EmptyChromosomeConstructor, chrom ChromosomeInterface) ChromosomeInterface {
+	genes := chrom.Genes()
+
+	copied := constr(genes.Len())
+	copied.Genes().Copy(genes, 0, 0, genes.Len())
+	if !isDirty(chrom) {
+		copied.SetCost(chrom.Cost())
+	}
+
+	return copied
+}
+
+// Tracks number of evaluations reported by island statistics
+type evaluationsCounter struct {
+	evaluations        int
+	skippedEvaluations int
+}
+
+func (counter *evaluationsCounter) delta(data StatisticsDataInterface) (evaluated, skipped int) {
+	stats, ok := data.(StatisticsDataDefault)
+	if !ok {
+		return 0, 0
+	}
+
+	evaluated = stats.Evaluations() - counter.evaluations
+	skipped = stats.SkippedEvaluations() - counter.skippedEvaluations
+	counter.evaluations = stats.Evaluations()
+	counter.skippedEvaluations = stats.SkippedEvaluations()
+	return
+}
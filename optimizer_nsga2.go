@@ -0,0 +1,241 @@
+package genetic_algorithm
+
+import (
+	"context"
+	"sort"
+)
+
+// NSGA-II multi-objective optimizer.
+// Each generation offspring of the population size are bred and mutated,
+// then the best half of parents and offspring by non-domination rank and crowding distance survive.
+//
+// Cost of chromosomes is set to rank + 0.5/(1+crowding distance), so selectors that prefer lower cost,
+// e.g. tournament ones, implement crowded comparison. Statistics and stop criterion work with that cost.
+// Parents are never mutated, only offspring is.
+//
+// Source: A Fast and Elitist Multiobjective Genetic Algorithm: NSGA-II. Deb K., Pratap A., Agarwal S., Meyarivan T. (2002)
+type NSGA2Optimizer struct {
+	*OptimizerBase
+
+	multiCostFunction    MultiCostFunction
+	crossoverProbability float64
+
+	costs map[ChromosomeInterface]MultiCost
+	front Chromosomes
+}
+
+func NewNSGA2Optimizer() *NSGA2Optimizer {
+	optimizer := &NSGA2Optimizer{}
+
+	optimizer.OptimizerBase = NewOptimizerBase(optimizer)
+	optimizer.selector = NewSimpleTournamentSelector(2)
+	optimizer.crossoverProbability = 1
+
+	return optimizer
+}
+
+// Used instead of CostFunction. Must be safe for concurrent use when parallelism is greater than 1.
+func (optimizer *NSGA2Optimizer) MultiCostFunction(cost MultiCostFunction) *NSGA2Optimizer {
+	optimizer.multiCostFunction = cost
+	return optimizer
+}
+
+// Probability of crossing parents, otherwise offspring are copies of parents. By default 1
+func (optimizer *NSGA2Optimizer) CrossoverProbability(crossoverProbability float64) *NSGA2Optimizer {
+	optimizer.crossoverProbability = crossoverProbability
+	return optimizer
+}
+
+// Panics on configuration errors, use OptimizeContext to get them as errors
+func (optimizer *NSGA2Optimizer) Optimize() ([]ParetoSolution, StatisticsDataInterface) {
+	front, data, err := optimizer.OptimizeContext(context.Background())
+	if err != nil {
+		panic(err)
+	}
+
+	return front, data
+}
+
+// Returns the Pareto front of the last population
+func (optimizer *NSGA2Optimizer) OptimizeContext(ctx context.Context) ([]ParetoSolution, StatisticsDataInterface, error) {
+	best, data, err := optimizer.OptimizerBase.OptimizeContext(ctx)
+	if best == nil {
+		return nil, data, err
+	}
+
+	front := make([]ParetoSolution, len(optimizer.front))
+	for i, chrom := range optimizer.front {
+		front[i] = ParetoSolution{chrom, optimizer.costs[chrom]}
+	}
+
+	return front, data, err
+}
+
+func (optimizer *NSGA2Optimizer) optimizeInner() {
+	offspring := optimizer.breed()
+	optimizer.mutate(offspring)
+
+	// Survivors are chosen by rankPopulation
+	optimizer.population = append(optimizer.population, offspring...)
+}
+func (optimizer *NSGA2Optimizer) breed() Chromosomes {
+	optimizer.statistics.Start("breed")
+	defer optimizer.statistics.End()
+
+	optimizer.selector.Prepare(optimizer.population)
+
+	offspring := make(Chromosomes, 0, optimizer.popSize)
+	for len(offspring) < optimizer.popSize {
+		parents := optimizer.selector.SelectMany(optimizer.crossover.ParentsCount())
+		if optimizer.log.logEnabled(LogLevelDebug) {
+			optimizer.log.debugf("Parents:\n%v", parents)
+		}
+
+		var children Chromosomes
+		if optimizer.crossoverProbability >= 1 || optimizer.crossoverProbability > optimizer.random.randFloat64() {
+			children = optimizer.crossover.Crossover(parents)
+		} else {
+			// Copies, so the mutator doesn't change parents
+			children = copiesOf(parents)
+		}
+		if left := optimizer.popSize - len(offspring); len(children) > left {
+			children = children[:left]
+		}
+		if optimizer.log.logEnabled(LogLevelDebug) {
+			optimizer.log.debugf("Children\n%v", children)
+		}
+
+		offspring = append(offspring, children...)
+	}
+
+	return offspring
+}
+func (optimizer *NSGA2Optimizer) mutate(offspring Chromosomes) {
+	optimizer.statistics.Start("mutate")
+	defer optimizer.statistics.End()
+
+	mutateOffspring(optimizer.mutator, offspring)
+}
+
+// Objectives are saved in checkpoints, so they aren't evaluated again on resume
+func (optimizer *NSGA2Optimizer) chromosomeCosts(chrom ChromosomeInterface) ([]float64, bool) {
+	cost, ok := optimizer.costs[chrom]
+	return cost, ok
+}
+func (optimizer *NSGA2Optimizer) restoreChromosomeCosts(chrom ChromosomeInterface, costs []float64) {
+	if optimizer.costs == nil {
+		optimizer.costs = make(map[ChromosomeInterface]MultiCost)
+	}
+	optimizer.costs[chrom] = costs
+}
+
+// Evaluates new chromosomes and chooses popSize survivors.
+// Survivors are sorted by cost, which is set from their rank and crowding distance.
+func (optimizer *NSGA2Optimizer) rankPopulation(population Chromosomes) Chromosomes {
+	costs := optimizer.evaluateMultiCost(population)
+
+	optimizer.statistics.Start("rank")
+	defer optimizer.statistics.End()
+
+	survivors := make(Chromosomes, 0, optimizer.popSize)
+	optimizer.costs = make(map[ChromosomeInterface]MultiCost, optimizer.popSize)
+	optimizer.front = nil
+
+	for rank, front := range nonDominatedSort(costs) {
+		distances := crowdingDistances(costs, front)
+
+		if left := optimizer.popSize - len(survivors); len(front) > left {
+			// The most isolated members of the last front survive
+			sort.Stable(byCrowdingDistance{front, distances})
+			front, distances = front[:left], distances[:left]
+		}
+
+		for i, ind := range front {
+			chrom := population[ind]
+			chrom.SetCost(float64(rank) + 0.5/(1+distances[i]))
+
+			survivors = append(survivors, chrom)
+			optimizer.costs[chrom] = costs[ind]
+		}
+		if rank == 0 {
+			optimizer.front = append(Chromosomes(nil), survivors...)
+		}
+
+		if len(survivors) == optimizer.popSize {
+			break
+		}
+	}
+
+	sort.Stable(survivors)
+	return survivors
+}
+
+// Returns costs of all chromosomes.
+// Costs of chromosomes that weren't changed since the previous generation are taken from it.
+func (optimizer *NSGA2Optimizer) evaluateMultiCost(population Chromosomes) []MultiCost {
+	optimizer.repairDirty(population)
+
+	optimizer.statistics.Start("cost")
+	defer optimizer.statistics.End()
+
+	costs := make([]MultiCost, len(population))
+	evaluated := make(map[ChromosomeInterface]int, len(population))
+
+	var indexes []int
+	for i, chrom := range population {
+		if cost, ok := optimizer.costs[chrom]; ok && !isDirty(chrom) {
+			costs[i] = cost
+			continue
+		}
+
+		// Population may contain the same chromosome several times
+		if _, ok := evaluated[chrom]; !ok {
+			evaluated[chrom] = i
+			indexes = append(indexes, i)
+		}
+	}
+
+	parallelFor(indexes, optimizer.parallelism, func(i int) {
+		costs[i] = optimizer.multiCostFunction(population[i])
+	})
+	for i, chrom := range population {
+		if costs[i] == nil {
+			costs[i] = costs[evaluated[chrom]]
+		}
+		if len(costs[i]) != len(costs[0]) {
+			panic(newConfigError("NSGA2Optimizer", "MultiCostFunction returned %d objectives, expected %d", len(costs[i]), len(costs[0])))
+		}
+	}
+
+	optimizer.onEvaluations(len(indexes), len(population)-len(indexes))
+	return costs
+}
+
+func (optimizer *NSGA2Optimizer) check() error {
+	if optimizer.multiCostFunction == nil {
+		return newConfigError("NSGA2Optimizer", "MultiCostFunction must be set")
+	}
+	if optimizer.costCacheSize > 0 {
+		return newConfigError("NSGA2Optimizer", "CostCache isn't supported")
+	}
+	if optimizer.constrainedCostFunction != nil {
+		return newConfigError("NSGA2Optimizer", "ConstrainedCostFunction isn't supported")
+	}
+	if optimizer.crossoverProbability <= 0 || optimizer.crossoverProbability > 1 {
+		return newConfigError("NSGA2Optimizer", "CrossoverProbability must be in (0, 1] range")
+	}
+	return nil
+}
+
+// Sorts front by crowding distance in descending order
+type byCrowdingDistance struct {
+	front     []int
+	distances []float64
+}
+
+func (c byCrowdingDistance) Len() int           { return len(c.front) }
+func (c byCrowdingDistance) Less(i, j int) bool { return c.distances[i] > c.distances[j] }
+func (c byCrowdingDistance) Swap(i, j int) {
+	c.front[i], c.front[j] = c.front[j], c.front[i]
+	c.distances[i], c.distances[j] = c.distances[j], c.distances[i]
+}
@@ -1,10 +1,5 @@
 package genetic_algorithm
 
-import (
-	log "github.com/cihub/seelog"
-	"math/rand"
-)
-
 type SimpleOptimizer struct {
 	*OptimizerBase
 
@@ -50,16 +45,22 @@ func (optimizer *SimpleOptimizer) breed() {
 
 	for {
 		chromsToCross := optimizer.selector.SelectMany(optimizer.crossover.ParentsCount())
-		log.Debugf("Parents:\n%v", chromsToCross)
+		if optimizer.log.logEnabled(LogLevelDebug) {
+			optimizer.log.debugf("Parents:\n%v", chromsToCross)
+		}
 
 		var newChromosomes Chromosomes
 
-		if optimizer.crossoverProbability > rand.Float64() {
+		if optimizer.crossoverProbability > optimizer.random.randFloat64() {
 			newChromosomes = optimizer.crossover.Crossover(chromsToCross)
-			log.Debugf("Children\n%v\n", newChromosomes)
+			if optimizer.log.logEnabled(LogLevelDebug) {
+				optimizer.log.debugf("Children\n%v", newChromosomes)
+			}
 		} else {
-			log.Debugf("Parents go to the new generation\n")
-			newChromosomes = chromsToCross
+			optimizer.log.debugf("Parents go to the new generation")
+
+			// Copies, so the mutator doesn't change elites and other chromosomes selected several times
+			newChromosomes = copiesOf(chromsToCross)
 		}
 
 		for i := 0; i < len(newChromosomes); i++ {
@@ -79,11 +80,12 @@ func (optimizer *SimpleOptimizer) mutate() {
 	optimizer.mutator.Mutate(optimizer.population)
 }
 
-func (optimizer *SimpleOptimizer) check() {
+func (optimizer *SimpleOptimizer) check() error {
 	if optimizer.elitism < 0 {
-		panic("Elitism must be positive")
+		return newConfigError("SimpleOptimizer", "Elitism must be positive")
 	}
 	if optimizer.crossoverProbability <= 0 || optimizer.crossoverProbability > 1 {
-		panic("CrossoverProbability must be in (0, 1] range")
+		return newConfigError("SimpleOptimizer", "CrossoverProbability must be in (0, 1] range")
 	}
+	return nil
 }
@@ -0,0 +1,111 @@
+package genetic_algorithm
+
+import (
+	"math/rand"
+)
+
+// Steady state optimizer produces a few offspring on each step
+// and inserts them into population by the replacement policy.
+// Population is kept sorted while children are inserted, so it is never re-sorted.
+//
+// Each step counts as a generation for statistics and stop criterion.
+// Mutator is applied to the offspring only.
+type SteadyStateOptimizer struct {
+	*OptimizerBase
+
+	replacement      ReplacementInterface
+	offspringPerStep int
+}
+
+func NewSteadyStateOptimizer() *SteadyStateOptimizer {
+	optimizer := &SteadyStateOptimizer{}
+
+	optimizer.OptimizerBase = NewOptimizerBase(optimizer)
+	optimizer.replacement = NewReplaceWorst()
+	optimizer.offspringPerStep = 2
+
+	return optimizer
+}
+
+// By default child replaces the worst chromosome
+func (optimizer *SteadyStateOptimizer) Replacement(replacement ReplacementInterface) *SteadyStateOptimizer {
+	optimizer.replacement = replacement
+	return optimizer
+}
+
+// Number of children produced on each step. By default 2
+func (optimizer *SteadyStateOptimizer) OffspringPerStep(count int) *SteadyStateOptimizer {
+	optimizer.offspringPerStep = count
+	return optimizer
+}
+
+// Passes optimizer's generator to the replacement
+func (optimizer *SteadyStateOptimizer) SetRand(rnd *rand.Rand) {
+	if randomized, ok := optimizer.replacement.(RandomizedInterface); ok {
+		randomized.SetRand(rnd)
+	}
+}
+
+// Passes optimizer's logger to the replacement
+func (optimizer *SteadyStateOptimizer) SetLogger(logger LoggerInterface) {
+	if loggable, ok := optimizer.replacement.(LoggableInterface); ok {
+		loggable.SetLogger(logger)
+	}
+}
+
+func (optimizer *SteadyStateOptimizer) optimizeInner() {
+	families, offspring := optimizer.breed()
+	optimizer.mutate(offspring)
+	optimizer.evaluate(offspring)
+	optimizer.replace(families)
+}
+func (optimizer *SteadyStateOptimizer) breed() ([]Family, Chromosomes) {
+	optimizer.statistics.Start("breed")
+	defer optimizer.statistics.End()
+
+	optimizer.selector.Prepare(optimizer.population)
+
+	families := make([]Family, 0, optimizer.offspringPerStep)
+	offspring := make(Chromosomes, 0, optimizer.offspringPerStep)
+	for len(offspring) < optimizer.offspringPerStep {
+		parents := optimizer.selector.SelectMany(optimizer.crossover.ParentsCount())
+		if optimizer.log.logEnabled(LogLevelDebug) {
+			optimizer.log.debugf("Parents:\n%v", parents)
+		}
+
+		children := optimizer.crossover.Crossover(parents)
+		if left := optimizer.offspringPerStep - len(offspring); len(children) > left {
+			children = children[:left]
+		}
+		if optimizer.log.logEnabled(LogLevelDebug) {
+			optimizer.log.debugf("Children\n%v", children)
+		}
+
+		families = append(families, Family{parents, children})
+		offspring = append(offspring, children...)
+	}
+
+	return families, offspring
+}
+func (optimizer *SteadyStateOptimizer) mutate(offspring Chromosomes) {
+	optimizer.statistics.Start("mutate")
+	defer optimizer.statistics.End()
+
+	mutateOffspring(optimizer.mutator, offspring)
+}
+func (optimizer *SteadyStateOptimizer) replace(families []Family) {
+	optimizer.statistics.Start("replace")
+	defer optimizer.statistics.End()
+
+	optimizer.replacement.Replace(optimizer.population, families)
+}
+
+func (optimizer *SteadyStateOptimizer) check() error {
+	if optimizer.replacement == nil {
+		return newConfigError("SteadyStateOptimizer", "Replacement must be set")
+	}
+	if optimizer.offspringPerStep <= 0 {
+		return newConfigError("SteadyStateOptimizer", "OffspringPerStep must be positive value")
+	}
+	return checkAll(optimizer.replacement)
+}
@@ -0,0 +1,109 @@
+package genetic_algorithm
+
+import (
+	"math"
+	"sort"
+)
+
+// Cost of multi-objective problem, all objectives are minimized
+type MultiCost []float64
+
+type MultiCostFunction func(ChromosomeInterface) MultiCost
+
+// Returns true if cost is not worse than other in all objectives and better in at least one
+func (cost MultiCost) Dominates(other MultiCost) bool {
+	better := false
+	for i := range cost {
+		if cost[i] > other[i] {
+			return false
+		}
+		if cost[i] < other[i] {
+			better = true
+		}
+	}
+	return better
+}
+
+// Chromosome of the Pareto front with its objectives
+type ParetoSolution struct {
+	Chromosome ChromosomeInterface
+	Cost       MultiCost
+}
+
+// Fast non-dominated sort.
+// Returns indexes of costs grouped by fronts, the first front is non-dominated.
+//
+// Source: A Fast and Elitist Multiobjective Genetic Algorithm: NSGA-II. Deb K., Pratap A., Agarwal S., Meyarivan T. (2002)
+func nonDominatedSort(costs []MultiCost) [][]int {
+	dominatedBy := make([]int, len(costs))
+	dominates := make([][]int, len(costs))
+
+	var front []int
+	for i := range costs {
+		for j := i + 1; j < len(costs); j++ {
+			if costs[i].Dominates(costs[j]) {
+				dominates[i] = append(dominates[i], j)
+				dominatedBy[j]++
+			} else if costs[j].Dominates(costs[i]) {
+				dominates[j] = append(dominates[j], i)
+				dominatedBy[i]++
+			}
+		}
+
+		if dominatedBy[i] == 0 {
+			front = append(front, i)
+		}
+	}
+
+	var fronts [][]int
+	for len(front) > 0 {
+		fronts = append(fronts, front)
+
+		var next []int
+		for _, i := range front {
+			for _, j := range dominates[i] {
+				dominatedBy[j]--
+				if dominatedBy[j] == 0 {
+					next = append(next, j)
+				}
+			}
+		}
+		sort.Ints(next)
+		front = next
+	}
+
+	return fronts
+}
+
+// Crowding distance of each member of the front, boundary members have infinite distance.
+// Returns distances in the order of front.
+func crowdingDistances(costs []MultiCost, front []int) []float64 {
+	distances := make([]float64, len(front))
+	if len(front) == 0 {
+		return distances
+	}
+
+	order := make([]int, len(front))
+	for objective := range costs[front[0]] {
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(a, b int) bool {
+			return costs[front[order[a]]][objective] < costs[front[order[b]]][objective]
+		})
+
+		first, last := order[0], order[len(order)-1]
+		distances[first] = math.Inf(1)
+		distances[last] = math.Inf(1)
+
+		span := costs[front[last]][objective] - costs[front[first]][objective]
+		if span == 0 {
+			continue
+		}
+		for i := 1; i < len(order)-1; i++ {
+			distances[order[i]] += (costs[front[order[i+1]]][objective] - costs[front[order[i-1]]][objective]) / span
+		}
+	}
+
+	return distances
+}
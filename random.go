@@ -0,0 +1,114 @@
+package genetic_algorithm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+)
+
+// Operators which use random numbers.
+// Optimizer passes its generator to them before optimization so the seed fully determines the run.
+type RandomizedInterface interface {
+	SetRand(*rand.Rand)
+}
+
+// Source of random numbers for operators.
+// Uses global math/rand source until SetRand is called.
+type randomizer struct {
+	rnd *rand.Rand
+}
+
+func (r *randomizer) SetRand(rnd *rand.Rand) {
+	r.rnd = rnd
+}
+func (r *randomizer) randIntn(n int) int {
+	if r.rnd == nil {
+		return rand.Intn(n)
+	}
+	return r.rnd.Intn(n)
+}
+func (r *randomizer) randFloat64() float64 {
+	if r.rnd == nil {
+		return rand.Float64()
+	}
+	return r.rnd.Float64()
+}
+func (r *randomizer) randNormFloat64() float64 {
+	if r.rnd == nil {
+		return rand.NormFloat64()
+	}
+	return r.rnd.NormFloat64()
+}
+
+func (r *randomizer) chooseTwoPointCrossSection(genesLen int, canProduceCopiesOfParents bool) (crossPoint1 int, crossPoint2 int) {
+	crossPoint1 = r.randIntn(genesLen)
+
+	if !canProduceCopiesOfParents && crossPoint1 == 0 {
+		crossPoint2 = r.randIntn(genesLen-1) + 1
+	} else {
+		crossPoint2 = r.randIntn(genesLen-crossPoint1) + 1 + crossPoint1
+	}
+	return
+}
+
+func (r *randomizer) chooseDifferentRandomNumbers(count, upperBound int) []int {
+	if upperBound < count {
+		panic(fmt.Sprintf("Can't select %d different numbers on inerval [0:%d)", count, upperBound))
+	}
+
+	numbersMap := make(map[int]bool, count)
+	numbersList := make([]int, count)
+	for i := 0; i < count; i++ {
+		for {
+			number := r.randIntn(upperBound)
+			if !numbersMap[number] {
+				numbersMap[number] = true
+				numbersList[i] = number
+				break
+			}
+		}
+	}
+
+	return numbersList
+}
+
+// Source of random numbers which state can be saved in a checkpoint.
+// Implements SplitMix64 generator.
+type RandomSource struct {
+	state uint64
+}
+
+func NewRandomSource(seed int64) *RandomSource {
+	source := new(RandomSource)
+
+	source.Seed(seed)
+
+	return source
+}
+func (source *RandomSource) Seed(seed int64) {
+	source.state = uint64(seed)
+}
+func (source *RandomSource) Uint64() uint64 {
+	source.state += 0x9e3779b97f4a7c15
+
+	z := source.state
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+func (source *RandomSource) Int63() int64 {
+	return int64(source.Uint64() >> 1)
+}
+func (source *RandomSource) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, source.state)
+	return data, nil
+}
+func (source *RandomSource) UnmarshalBinary(data []byte) error {
+	if len(data) != 8 {
+		return fmt.Errorf("Unexpected random source state length %d", len(data))
+	}
+
+	source.state = binary.LittleEndian.Uint64(data)
+	return nil
+}
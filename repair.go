@@ -0,0 +1,176 @@
+package genetic_algorithm
+
+// Fixes chromosomes that violate domain rules.
+// Optimizer repairs new and changed chromosomes after crossover and mutation, before evaluation.
+// Repair must mark chromosome dirty if it changes genes.
+type RepairInterface interface {
+	Repair(chrom ChromosomeInterface)
+}
+
+// Makes permutation of ordered chromosome valid.
+// Duplicated genes and genes out of [0, len) range, e.g. -1 left by crossover, are replaced by missing ones in ascending order.
+// The first occurrence of a duplicated gene is kept.
+type PermutationRepair struct {
+	logging
+}
+
+func NewPermutationRepair() *PermutationRepair {
+	return new(PermutationRepair)
+}
+func (repair *PermutationRepair) Repair(chrom ChromosomeInterface) {
+	ordered, ok := chrom.(*OrderedChromosome)
+	if !ok {
+		panic("Expects OrderedChromosome")
+	}
+
+	if repair.repairGenes(ordered.OrderedGenes()) {
+		MarkDirty(chrom)
+
+		if repair.logEnabled(LogLevelTrace) {
+			repair.tracef("Repaired permutation %v", chrom)
+		}
+	}
+}
+
+// Returns true if genes were changed
+func (repair *PermutationRepair) repairGenes(genes OrderedGenes) bool {
+	present := make([]bool, len(genes))
+	var invalid []int
+	for i, gene := range genes {
+		if gene < 0 || gene >= len(genes) || present[gene] {
+			invalid = append(invalid, i)
+			continue
+		}
+		present[gene] = true
+	}
+
+	if len(invalid) == 0 {
+		return false
+	}
+
+	missing := 0
+	for _, ind := range invalid {
+		for present[missing] {
+			missing++
+		}
+
+		genes[ind] = missing
+		present[missing] = true
+	}
+	return true
+}
+
+// Reorders ordered chromosome so that each gene comes after its predecessors.
+// Relative order of genes is kept as far as precedence constraints allow:
+// each next position is taken by the earliest gene which predecessors are already placed.
+// Invalid permutations are fixed by PermutationRepair first.
+type PrecedenceRepair struct {
+	configChecker
+	logging
+
+	permutation  *PermutationRepair
+	predecessors [][]int
+}
+
+// predecessors[i] lists genes that must precede gene i
+func NewPrecedenceRepair(predecessors [][]int) *PrecedenceRepair {
+	repair := new(PrecedenceRepair)
+
+	repair.permutation = NewPermutationRepair()
+	repair.predecessors = predecessors
+
+	for gene, genePredecessors := range predecessors {
+		for _, predecessor := range genePredecessors {
+			if predecessor < 0 || predecessor >= len(predecessors) || predecessor == gene {
+				repair.invalid("PrecedenceRepair", "Incorrect predecessor %d of gene %d", predecessor, gene)
+				return repair
+			}
+		}
+	}
+	if repair.hasCycle() {
+		repair.invalid("PrecedenceRepair", "Precedence constraints contain cycle")
+	}
+
+	return repair
+}
+func (repair *PrecedenceRepair) hasCycle() bool {
+	genes := make(OrderedGenes, len(repair.predecessors))
+	for i := range genes {
+		genes[i] = i
+	}
+
+	_, ok := repair.sort(genes)
+	return !ok
+}
+
+func (repair *PrecedenceRepair) SetLogger(logger LoggerInterface) {
+	repair.logging.SetLogger(logger)
+	repair.permutation.SetLogger(logger)
+}
+
+func (repair *PrecedenceRepair) Repair(chrom ChromosomeInterface) {
+	ordered, ok := chrom.(*OrderedChromosome)
+	if !ok {
+		panic("Expects OrderedChromosome")
+	}
+
+	genes := ordered.OrderedGenes()
+	if len(genes) != len(repair.predecessors) {
+		panic(newConfigError("PrecedenceRepair", "Predecessors are set for %d genes, got %d", len(repair.predecessors), len(genes)))
+	}
+
+	changed := repair.permutation.repairGenes(genes)
+
+	sorted, _ := repair.sort(genes)
+	for i := range genes {
+		if genes[i] != sorted[i] {
+			genes[i] = sorted[i]
+			changed = true
+		}
+	}
+
+	if changed {
+		MarkDirty(chrom)
+
+		if repair.logEnabled(LogLevelTrace) {
+			repair.tracef("Repaired precedence %v", chrom)
+		}
+	}
+}
+
+// Stable topological sort of permutation.
+// Returns false if not all genes can be placed because of cycle.
+func (repair *PrecedenceRepair) sort(genes OrderedGenes) (OrderedGenes, bool) {
+	waiting := make([]int, len(genes))
+	successors := make([][]int, len(genes))
+	for gene, genePredecessors := range repair.predecessors {
+		waiting[gene] = len(genePredecessors)
+		for _, predecessor := range genePredecessors {
+			successors[predecessor] = append(successors[predecessor], gene)
+		}
+	}
+
+	sorted := make(OrderedGenes, 0, len(genes))
+	placed := make([]bool, len(genes))
+	for len(sorted) < len(genes) {
+		next := -1
+		for i, gene := range genes {
+			if !placed[i] && waiting[gene] == 0 {
+				next = i
+				break
+			}
+		}
+		if next == -1 {
+			return sorted, false
+		}
+
+		gene := genes[next]
+		placed[next] = true
+		sorted = append(sorted, gene)
+		for _, successor := range successors[gene] {
+			waiting[successor]--
+		}
+	}
+
+	return sorted, true
+}
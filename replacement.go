@@ -0,0 +1,142 @@
+package genetic_algorithm
+
+import (
+	"sort"
+)
+
+// Parents and children produced by one crossover
+type Family struct {
+	Parents  Chromosomes
+	Children Chromosomes
+}
+
+// Inserts offspring of the steady state optimizer into population.
+type ReplacementInterface interface {
+	// Population is sorted by cost and costs of children are set.
+	// Replacement must keep population size and order.
+	Replace(population Chromosomes, families []Family)
+}
+
+// Child replaces the worst chromosome of the population.
+type ReplaceWorst struct{}
+
+func NewReplaceWorst() *ReplaceWorst {
+	return new(ReplaceWorst)
+}
+func (replacement *ReplaceWorst) Replace(population Chromosomes, families []Family) {
+	for _, family := range families {
+		for _, child := range family.Children {
+			population.replaceSorted(len(population)-1, child)
+		}
+	}
+}
+
+// Child replaces the worst of randomly chosen contestants.
+// The best chromosome[s] can't be replaced.
+type ReverseTournamentReplacement struct {
+	randomizer
+	configChecker
+
+	contestants int
+	elitism     int
+}
+
+func NewReverseTournamentReplacement(contestants int) *ReverseTournamentReplacement {
+	replacement := new(ReverseTournamentReplacement)
+
+	if contestants < 1 {
+		replacement.invalid("ReverseTournamentReplacement", "Must be at least one contestant")
+	}
+
+	replacement.contestants = contestants
+	replacement.elitism = 1
+
+	return replacement
+}
+
+// The best chromosome[s] can't be replaced. By default 1
+func (replacement *ReverseTournamentReplacement) WithElitism(count int) *ReverseTournamentReplacement {
+	if count < 0 {
+		replacement.invalid("ReverseTournamentReplacement", "Elitism can't be negative")
+		return replacement
+	}
+
+	replacement.elitism = count
+	return replacement
+}
+func (replacement *ReverseTournamentReplacement) Replace(population Chromosomes, families []Family) {
+	if len(population) <= replacement.elitism {
+		panic(newConfigError("ReverseTournamentReplacement", "Elitism must be less than population size"))
+	}
+
+	for _, family := range families {
+		for _, child := range family.Children {
+			// Population is sorted, the worst contestant has the biggest index
+			loser := -1
+			for i := 0; i < replacement.contestants; i++ {
+				ind := replacement.randIntn(len(population)-replacement.elitism) + replacement.elitism
+				if ind > loser {
+					loser = ind
+				}
+			}
+
+			population.replaceSorted(loser, child)
+		}
+	}
+}
+
+// Child replaces the worst of its parents if the child is better.
+// Each parent can be replaced only once.
+type ReplaceParentIfBetter struct{}
+
+func NewReplaceParentIfBetter() *ReplaceParentIfBetter {
+	return new(ReplaceParentIfBetter)
+}
+func (replacement *ReplaceParentIfBetter) Replace(population Chromosomes, families []Family) {
+	for _, family := range families {
+		parents := make(Chromosomes, len(family.Parents))
+		copy(parents, family.Parents)
+
+		for _, child := range family.Children {
+			worst := -1
+			for i, parent := range parents {
+				if parent != nil && (worst == -1 || parent.Cost() > parents[worst].Cost()) {
+					worst = i
+				}
+			}
+			if worst == -1 || child.Cost() >= parents[worst].Cost() {
+				continue
+			}
+
+			ind := population.index(parents[worst])
+			if ind == -1 {
+				continue
+			}
+
+			parents[worst] = nil
+			population.replaceSorted(ind, child)
+		}
+	}
+}
+
+// Removes chromosome at ind and inserts chrom keeping population sorted.
+// Chrom is placed after chromosomes with the same cost.
+func (c Chromosomes) replaceSorted(ind int, chrom ChromosomeInterface) {
+	copy(c[ind:], c[ind+1:])
+	rest := c[:len(c)-1]
+
+	pos := sort.Search(len(rest), func(i int) bool { return rest[i].Cost() > chrom.Cost() })
+
+	copy(c[pos+1:], c[pos:len(c)-1])
+	c[pos] = chrom
+}
+
+// Returns index of the chromosome or -1
+func (c Chromosomes) index(chrom ChromosomeInterface) int {
+	for i, other := range c {
+		if other == chrom {
+			return i
+		}
+	}
+	return -1
+}
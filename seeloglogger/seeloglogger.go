@@ -0,0 +1,53 @@
+// Package seeloglogger writes logs of the genetic algorithm to seelog.
+package seeloglogger
+
+import (
+	. "github.com/WiseBird/genetic_algorithm"
+	log "github.com/cihub/seelog"
+)
+
+// Adapter of seelog logger to LoggerInterface
+type Logger struct {
+	logger   log.LoggerInterface
+	minLevel LogLevel
+}
+
+// Nil logger means seelog's current global logger, which can be replaced later with log.ReplaceLogger.
+func New(logger log.LoggerInterface) *Logger {
+	adapter := new(Logger)
+
+	adapter.logger = logger
+	adapter.minLevel = LogLevelTrace
+
+	return adapter
+}
+
+// Messages of lower levels aren't formatted. By default all levels are passed to seelog.
+// Seelog doesn't report its constraints, so set it to the minlevel of the seelog config to save formatting.
+func (adapter *Logger) MinLevel(level LogLevel) *Logger {
+	adapter.minLevel = level
+	return adapter
+}
+
+func (adapter *Logger) Enabled(level LogLevel) bool {
+	return level >= adapter.minLevel
+}
+func (adapter *Logger) Logf(level LogLevel, format string, args ...interface{}) {
+	logger := adapter.logger
+	if logger == nil {
+		logger = log.Current
+	}
+
+	switch level {
+	case LogLevelTrace:
+		logger.Tracef(format, args...)
+	case LogLevelDebug:
+		logger.Debugf(format, args...)
+	case LogLevelInfo:
+		logger.Infof(format, args...)
+	case LogLevelWarn:
+		logger.Warnf(format, args...)
+	default:
+		logger.Errorf(format, args...)
+	}
+}
@@ -1,13 +1,11 @@
 package genetic_algorithm
 
-import (
-	"fmt"
-	log "github.com/cihub/seelog"
-)
-
 // Base class for selectors.
 type SelectorBase struct {
 	SelectorBaseVirtualMInterface
+	randomizer
+	configChecker
+	logging
 
 	population       Chromosomes
 	selectManyUnique bool
@@ -18,6 +16,18 @@ type SelectorBaseVirtualMInterface interface {
 	SelectInd() int
 }
 
+// Optional virtual method for selectors that choose all parents of SelectMany at once.
+// Returned indexes are made unique by SelectorBase when SelectManyAreUnique is set.
+type selectorBaseSelectManyVirtualMInterface interface {
+	selectManyInd(count int) []int
+}
+
+// Optional virtual method for selectors that choose only among the first chromosomes of population.
+// Unique chromosomes of SelectMany are searched among them too.
+type selectorBaseSelectableVirtualMInterface interface {
+	selectableCount() int
+}
+
 // Constructor for SelectorBase
 func NewSelectorBase(virtual SelectorBaseVirtualMInterface) *SelectorBase {
 	selector := new(SelectorBase)
@@ -35,7 +45,9 @@ func (selector *SelectorBase) SelectManyAreUnique(value bool) *SelectorBase {
 }
 
 func (selector *SelectorBase) Prepare(population Chromosomes) {
-	log.Tracef("Prepare Population=%d\n", len(population))
+	if selector.logEnabled(LogLevelTrace) {
+		selector.tracef("Prepare Population=%d", len(population))
+	}
 
 	selector.population = population
 }
@@ -44,21 +56,39 @@ func (selector *SelectorBase) Select() ChromosomeInterface {
 	return selector.population[selector.SelectorBaseVirtualMInterface.SelectInd()]
 }
 func (selector *SelectorBase) SelectMany(count int) Chromosomes {
-	log.Tracef("SelectMany c=%d\n", count)
+	if selector.logEnabled(LogLevelTrace) {
+		selector.tracef("SelectMany c=%d", count)
+	}
 
 	if count < 0 {
 		panic("Count must be greater than 0")
 	}
 
-	if len(selector.population) < count && selector.selectManyUnique {
-		panic(fmt.Sprintf("Cant select %d unique chroms from %d chroms", count, len(selector.population)))
+	selectable := len(selector.population)
+	if limited, ok := selector.SelectorBaseVirtualMInterface.(selectorBaseSelectableVirtualMInterface); ok {
+		selectable = limited.selectableCount()
+	}
+
+	if selectable < count && selector.selectManyUnique {
+		panic(newConfigError("Selector", "Cant select %d unique chroms from %d chroms", count, selectable))
+	}
+
+	var inds []int
+	if many, ok := selector.SelectorBaseVirtualMInterface.(selectorBaseSelectManyVirtualMInterface); ok {
+		inds = many.selectManyInd(count)
 	}
 
 	chroms := make(Chromosomes, count)
 	selected := make(map[int]bool, count)
 
 	for i := 0; i < count; i++ {
-		ind := selector.SelectorBaseVirtualMInterface.SelectInd()
+		var ind int
+		if inds != nil {
+			ind = inds[i]
+		} else {
+			ind = selector.SelectorBaseVirtualMInterface.SelectInd()
+		}
+
 		if selector.selectManyUnique && selected[ind] {
 			j := 1
 			for {
@@ -66,7 +96,7 @@ func (selector *SelectorBase) SelectMany(count int) Chromosomes {
 					ind = ind - j
 					break
 				}
-				if !selected[ind-j] && ind+j < len(selector.population) {
+				if !selected[ind+j] && ind+j < selectable {
 					ind = ind + j
 					break
 				}
@@ -77,7 +107,9 @@ func (selector *SelectorBase) SelectMany(count int) Chromosomes {
 		selected[ind] = true
 		chrom := selector.population[ind]
 
-		log.Debugf("Parent[%d] - %v", i, chrom)
+		if selector.logEnabled(LogLevelDebug) {
+			selector.debugf("Parent[%d] - %v", i, chrom)
+		}
 		chroms[i] = chrom
 	}
 
@@ -0,0 +1,122 @@
+package genetic_algorithm
+
+import (
+	"math"
+)
+
+// Base of selectors that choose individuals with probability depending only on their rank
+type rankingSelector struct {
+	*SelectorBase
+
+	virtualMethods  rankingSelectorVirtualMInterface
+	probabilitySums []float64
+}
+type rankingSelectorVirtualMInterface interface {
+	// Probability of i-th best individual to be selected from population of size n
+	probability(i, n int) float64
+}
+
+func newRankingSelector(vm rankingSelectorVirtualMInterface) *rankingSelector {
+	selector := new(rankingSelector)
+
+	selector.SelectorBase = NewSelectorBase(selector)
+	selector.virtualMethods = vm
+
+	return selector
+}
+func (selector *rankingSelector) Prepare(population Chromosomes) {
+	selector.tracef("Preparing")
+
+	selector.SelectorBase.Prepare(population)
+
+	if len(selector.probabilitySums) != len(population) {
+		selector.recalcProbabilities(len(population))
+	}
+}
+func (selector *rankingSelector) recalcProbabilities(n int) {
+	selector.probabilitySums = make([]float64, n)
+
+	sum := 0.0
+	for i := 0; i < n; i++ {
+		sum += selector.virtualMethods.probability(i, n)
+		selector.probabilitySums[i] = sum
+	}
+
+	if selector.logEnabled(LogLevelTrace) {
+		selector.tracef("Recalced probabilities %v", selector.probabilitySums)
+	}
+}
+func (selector *rankingSelector) SelectInd() int {
+	rnd := selector.randFloat64()
+
+	// Last individual takes the rest to avoid rounding errors
+	for i := 0; i < len(selector.probabilitySums)-1; i++ {
+		if rnd < selector.probabilitySums[i] {
+			if selector.logEnabled(LogLevelTrace) {
+				selector.tracef("Found chrom on %d", i)
+			}
+			return i
+		}
+	}
+	return len(selector.probabilitySums) - 1
+}
+
+// Selects individuals with probability decreasing linearly with their rank.
+//
+// Selective pressure is in [1, 2] and is the expected number of offspring of the best individual.
+// Pressure 1 gives uniform selection, pressure 2 gives zero probability to the worst individual.
+//
+// Source: Baker, J. E. Adaptive Selection Methods for Genetic Algorithms (1985)
+type LinearRankingSelector struct {
+	*rankingSelector
+
+	pressure float64
+}
+
+func NewLinearRankingSelector(pressure float64) *LinearRankingSelector {
+	selector := new(LinearRankingSelector)
+
+	selector.rankingSelector = newRankingSelector(selector)
+
+	if pressure < 1 || pressure > 2 {
+		selector.invalid("LinearRankingSelector", "Pressure out of range")
+	}
+
+	selector.pressure = pressure
+
+	return selector
+}
+func (selector *LinearRankingSelector) probability(i, n int) float64 {
+	if n == 1 {
+		return 1
+	}
+
+	return (selector.pressure - 2*(selector.pressure-1)*float64(i)/float64(n-1)) / float64(n)
+}
+
+// Selects individuals with probability decreasing exponentially with their rank,
+// i-th best individual is selected with probability proportional to base^i.
+//
+// Base is in (0, 1), lesser base gives higher selective pressure.
+type ExponentialRankingSelector struct {
+	*rankingSelector
+
+	base float64
+}
+
+func NewExponentialRankingSelector(base float64) *ExponentialRankingSelector {
+	selector := new(ExponentialRankingSelector)
+
+	selector.rankingSelector = newRankingSelector(selector)
+
+	if base <= 0 || base >= 1 {
+		selector.invalid("ExponentialRankingSelector", "Base out of range")
+	}
+
+	selector.base = base
+
+	return selector
+}
+func (selector *ExponentialRankingSelector) probability(i, n int) float64 {
+	return math.Pow(selector.base, float64(i)) * (1 - selector.base) / (1 - math.Pow(selector.base, float64(n)))
+}
@@ -1,10 +1,5 @@
 package genetic_algorithm
 
-import (
-	log "github.com/cihub/seelog"
-	"math/rand"
-)
-
 // Selects individual with probability proportional to it fitness value.
 // Warning! In order to use this selector cost value must be normalized, i.e. chromosome with cost=0 is the best solution.
 type RouletteWheelCostWeightingSelector struct {
@@ -21,7 +16,7 @@ func NewRouletteWheelCostWeightingSelector() *RouletteWheelCostWeightingSelector
 	return selector
 }
 func (selector *RouletteWheelCostWeightingSelector) Prepare(population Chromosomes) {
-	log.Tracef("Preparing")
+	selector.tracef("Preparing")
 
 	selector.SelectorBase.Prepare(population)
 
@@ -34,17 +29,19 @@ func (selector *RouletteWheelCostWeightingSelector) Prepare(population Chromosom
 
 	selector.fitnessSum = fitnessSum
 
-	log.Tracef("Prepared fs=%f\n", selector.fitnessSum)
+	if selector.logEnabled(LogLevelTrace) {
+		selector.tracef("Prepared fs=%f", selector.fitnessSum)
+	}
 }
 func (selector *RouletteWheelCostWeightingSelector) fitness(cost float64) float64 {
 	if cost < 0 {
-		panic("Can't calc fitness for negative cost")
+		panic(newConfigError("RouletteWheelCostWeightingSelector", "Can't calc fitness for negative cost %v", cost))
 	}
 
 	return 1 / (cost + 1)
 }
 func (selector *RouletteWheelCostWeightingSelector) SelectInd() int {
-	rnd := rand.Float64() * selector.fitnessSum
+	rnd := selector.randFloat64() * selector.fitnessSum
 
 	sum := 0.0
 	for i := 0; i < len(selector.population); i++ {
@@ -52,7 +49,9 @@ func (selector *RouletteWheelCostWeightingSelector) SelectInd() int {
 		sum += selector.fitness(chrom.Cost())
 
 		if rnd < sum {
-			log.Tracef("Found chrom %v, on %d", chrom, i)
+			if selector.logEnabled(LogLevelTrace) {
+				selector.tracef("Found chrom %v, on %d", chrom, i)
+			}
 			return i
 		}
 	}
@@ -1,10 +1,5 @@
 package genetic_algorithm
 
-import (
-	log "github.com/cihub/seelog"
-	"math/rand"
-)
-
 // Selects individual with probability proportional to it fitness value.
 // Warning! In order to use this selector cost value must be normalized, i.e. chromosome with cost=0 is the best solution.
 type RouletteWheelRankWeightingSelector struct {
@@ -21,7 +16,7 @@ func NewRouletteWheelRankWeightingSelector() *RouletteWheelRankWeightingSelector
 	return selector
 }
 func (selector *RouletteWheelRankWeightingSelector) Prepare(population Chromosomes) {
-	log.Tracef("Preparing")
+	selector.tracef("Preparing")
 
 	selector.SelectorBase.Prepare(population)
 
@@ -37,17 +32,21 @@ func (selector *RouletteWheelRankWeightingSelector) recalcWeights(n int) {
 		selector.weights[i-1] = float64(n+1-i) / sum
 	}
 
-	log.Tracef("Recalced weights %v\n", selector.weights)
+	if selector.logEnabled(LogLevelTrace) {
+		selector.tracef("Recalced weights %v", selector.weights)
+	}
 }
 func (selector *RouletteWheelRankWeightingSelector) SelectInd() int {
-	rnd := rand.Float64()
+	rnd := selector.randFloat64()
 
 	sum := 0.0
 	for i := 0; i < len(selector.population); i++ {
 		sum += selector.weights[i]
 
 		if rnd < sum {
-			log.Tracef("Found chrom on %d", i)
+			if selector.logEnabled(LogLevelTrace) {
+				selector.tracef("Found chrom on %d", i)
+			}
 			return i
 		}
 	}
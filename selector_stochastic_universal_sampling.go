@@ -0,0 +1,78 @@
+package genetic_algorithm
+
+// Selects individuals with probability proportional to their fitness values like roulette wheel,
+// but SelectMany chooses all parents with one spin of evenly spaced pointers.
+// Selected parents are shuffled, so they aren't ordered by fitness.
+// SelectMany returns unique chromosomes by default, repeated hits of a chromosome are replaced
+// by its neighbours in population, so copies expected by fitness are lost. Use SelectManyAreUnique(false) to keep them.
+// Warning! In order to use this selector cost value must be normalized, i.e. chromosome with cost=0 is the best solution.
+//
+// See http://en.wikipedia.org/wiki/Stochastic_universal_sampling
+type StochasticUniversalSamplingSelector struct {
+	*SelectorBase
+
+	fitnessSums []float64
+}
+
+func NewStochasticUniversalSamplingSelector() *StochasticUniversalSamplingSelector {
+	selector := new(StochasticUniversalSamplingSelector)
+
+	selector.SelectorBase = NewSelectorBase(selector)
+
+	return selector
+}
+func (selector *StochasticUniversalSamplingSelector) Prepare(population Chromosomes) {
+	selector.tracef("Preparing")
+
+	selector.SelectorBase.Prepare(population)
+
+	selector.fitnessSums = make([]float64, len(population))
+	sum := 0.0
+	for i, chrom := range population {
+		sum += selector.fitness(chrom.Cost())
+		selector.fitnessSums[i] = sum
+	}
+
+	if selector.logEnabled(LogLevelTrace) {
+		selector.tracef("Prepared fs=%f", sum)
+	}
+}
+func (selector *StochasticUniversalSamplingSelector) fitness(cost float64) float64 {
+	if cost < 0 {
+		panic(newConfigError("StochasticUniversalSamplingSelector", "Can't calc fitness for negative cost %v", cost))
+	}
+
+	return 1 / (cost + 1)
+}
+func (selector *StochasticUniversalSamplingSelector) SelectInd() int {
+	return selector.selectManyInd(1)[0]
+}
+func (selector *StochasticUniversalSamplingSelector) selectManyInd(count int) []int {
+	inds := make([]int, count)
+	if count == 0 {
+		return inds
+	}
+
+	step := selector.fitnessSums[len(selector.fitnessSums)-1] / float64(count)
+	pointer := selector.randFloat64() * step
+
+	ind := 0
+	for i := 0; i < count; i++ {
+		for ind < len(selector.fitnessSums)-1 && selector.fitnessSums[ind] <= pointer {
+			ind++
+		}
+		inds[i] = ind
+		pointer += step
+	}
+
+	if selector.logEnabled(LogLevelTrace) {
+		selector.tracef("Pointers hit %v", inds)
+	}
+
+	for i := count - 1; i > 0; i-- {
+		j := selector.randIntn(i + 1)
+		inds[i], inds[j] = inds[j], inds[i]
+	}
+
+	return inds
+}
@@ -1,9 +1,5 @@
 package genetic_algorithm
 
-import (
-	"math/rand"
-)
-
 // Selects several random individuals from population and then selectd best.
 type SimpleTournamentSelector struct {
 	*SelectorBase
@@ -14,13 +10,14 @@ type SimpleTournamentSelector struct {
 // Constructor for simple tournament selector.
 // When contestants = 1 behaves like random selector
 func NewSimpleTournamentSelector(contestants int) *SimpleTournamentSelector {
-	if contestants < 1 {
-		panic("Must be at least one contestant")
-	}
-
 	selector := new(SimpleTournamentSelector)
 
 	selector.SelectorBase = NewSelectorBase(selector)
+
+	if contestants < 1 {
+		selector.invalid("SimpleTournamentSelector", "Must be at least one contestant")
+	}
+
 	selector.contestants = contestants
 
 	return selector
@@ -34,7 +31,7 @@ func (selector *SimpleTournamentSelector) SelectInd() int {
 	bestInd := -1
 
 	for i := 0; i < selector.contestants; i++ {
-		ind := rand.Intn(len(selector.population))
+		ind := selector.randIntn(len(selector.population))
 		chrom := selector.population[ind]
 
 		if bestInd == -1 || chrom.Cost() < bestCost {
@@ -2,7 +2,6 @@ package genetic_algorithm
 
 import (
 	"math"
-	"math/rand"
 	"sort"
 )
 
@@ -20,16 +19,17 @@ type TournamentSelector struct {
 // Probability specify the probability that the best individual will be selected.
 // Must be equal or grater than 0.5
 func NewTournamentSelector(probability float64, contestants int) *TournamentSelector {
+	selector := new(TournamentSelector)
+
+	selector.SelectorBase = NewSelectorBase(selector)
+
 	if probability < 0.5 || probability > 1 {
-		panic("Probability out of range")
+		selector.invalid("TournamentSelector", "Probability out of range")
 	}
 	if contestants < 1 {
-		panic("Must be at least one contestant")
+		selector.invalid("TournamentSelector", "Must be at least one contestant")
 	}
 
-	selector := new(TournamentSelector)
-
-	selector.SelectorBase = NewSelectorBase(selector)
 	selector.probability = probability
 	selector.contestants = contestants
 
@@ -39,13 +39,13 @@ func (selector *TournamentSelector) SelectInd() int {
 	contestants := make([]int, selector.contestants)
 
 	for i := 0; i < selector.contestants; i++ {
-		contestants[i] = rand.Intn(len(selector.population))
+		contestants[i] = selector.randIntn(len(selector.population))
 	}
 
 	// Our population is sortes. Lesser index lesser cost.
 	sort.Sort(sort.IntSlice(contestants))
 
-	r := rand.Float64()
+	r := selector.randFloat64()
 	prob := float64(0)
 	for i := 0; i < selector.contestants-1; i++ {
 		prob += selector.ithProbability(i)
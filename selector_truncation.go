@@ -0,0 +1,39 @@
+package genetic_algorithm
+
+// Selects uniformly among the best part of population.
+// Unique chromosomes of SelectMany are selected among the best part too, so their count can't exceed its size.
+// See http://en.wikipedia.org/wiki/Truncation_selection
+type TruncationSelector struct {
+	*SelectorBase
+
+	proportion float64
+}
+
+// Proportion of population, from which parents are selected, must be in (0, 1]
+func NewTruncationSelector(proportion float64) *TruncationSelector {
+	selector := new(TruncationSelector)
+
+	selector.SelectorBase = NewSelectorBase(selector)
+
+	if proportion <= 0 || proportion > 1 {
+		selector.invalid("TruncationSelector", "Proportion out of range")
+	}
+
+	selector.proportion = proportion
+
+	return selector
+}
+func (selector *TruncationSelector) SelectInd() int {
+	// Population is sorted, the best individuals are at the beginning
+	return selector.randIntn(selector.truncatedSize())
+}
+func (selector *TruncationSelector) selectableCount() int {
+	return selector.truncatedSize()
+}
+func (selector *TruncationSelector) truncatedSize() int {
+	size := round(selector.proportion * float64(len(selector.population)))
+	if size < 1 {
+		return 1
+	}
+	return size
+}
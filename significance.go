@@ -0,0 +1,125 @@
+package genetic_algorithm
+
+import (
+	"math"
+	"sort"
+)
+
+// Mann-Whitney U test of two independent samples.
+// Returns U of the first sample and two-sided p-value of the normal approximation
+// with tie and continuity corrections.
+func MannWhitneyU(x, y []float64) (u, p float64) {
+	n1, n2 := float64(len(x)), float64(len(y))
+	if n1 == 0 || n2 == 0 {
+		return math.NaN(), math.NaN()
+	}
+
+	ranks, ties := averageRanks(append(append([]float64{}, x...), y...))
+
+	var rankSum float64
+	for i := range x {
+		rankSum += ranks[i]
+	}
+	u = rankSum - n1*(n1+1)/2
+
+	n := n1 + n2
+	mean := n1 * n2 / 2
+	variance := n1 * n2 / 12 * ((n + 1) - ties/(n*(n-1)))
+
+	return u, normalTwoSidedP(math.Abs(u-mean), variance)
+}
+
+// Wilcoxon signed-rank test of paired samples.
+// Returns sum of ranks of positive differences x[i]-y[i] and two-sided p-value of the normal approximation
+// with tie and continuity corrections. Zero differences are dropped.
+func WilcoxonSignedRank(x, y []float64) (w, p float64) {
+	if len(x) != len(y) {
+		panic("Expects paired samples of equal size")
+	}
+
+	var diffs []float64
+	for i := range x {
+		if d := x[i] - y[i]; d != 0 {
+			diffs = append(diffs, d)
+		}
+	}
+	if len(diffs) == 0 {
+		return 0, 1
+	}
+
+	abs := make([]float64, len(diffs))
+	for i, d := range diffs {
+		abs[i] = math.Abs(d)
+	}
+	ranks, ties := averageRanks(abs)
+
+	for i, d := range diffs {
+		if d > 0 {
+			w += ranks[i]
+		}
+	}
+
+	n := float64(len(diffs))
+	mean := n * (n + 1) / 4
+	variance := n*(n+1)*(2*n+1)/24 - ties/48
+
+	return w, normalTwoSidedP(math.Abs(w-mean), variance)
+}
+
+// Vargha-Delaney A effect size: probability that a value from x is greater than a value from y,
+// ties count as half. 0.5 means no effect.
+func VarghaDelaneyA(x, y []float64) float64 {
+	n1, n2 := float64(len(x)), float64(len(y))
+	if n1 == 0 || n2 == 0 {
+		return math.NaN()
+	}
+
+	ranks, _ := averageRanks(append(append([]float64{}, x...), y...))
+
+	var rankSum float64
+	for i := range x {
+		rankSum += ranks[i]
+	}
+	return (rankSum/n1 - (n1+1)/2) / n2
+}
+
+// Ranks starting from 1, tied values get the mean of their ranks.
+// Also returns sum of t^3-t over groups of t tied values.
+func averageRanks(values []float64) (ranks []float64, ties float64) {
+	order := make([]int, len(values))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return values[order[i]] < values[order[j]]
+	})
+
+	ranks = make([]float64, len(values))
+	for start := 0; start < len(order); {
+		end := start + 1
+		for end < len(order) && values[order[end]] == values[order[start]] {
+			end++
+		}
+
+		rank := float64(start+end+1) / 2
+		for _, i := range order[start:end] {
+			ranks[i] = rank
+		}
+
+		t := float64(end - start)
+		ties += t*t*t - t
+		start = end
+	}
+
+	return ranks, ties
+}
+
+// Two-sided p-value of deviation from the mean of normal distribution, with continuity correction
+func normalTwoSidedP(deviation, variance float64) float64 {
+	if variance <= 0 {
+		return 1
+	}
+
+	z := math.Max(deviation-0.5, 0) / math.Sqrt(variance)
+	return math.Min(math.Erfc(z/math.Sqrt2), 1)
+}
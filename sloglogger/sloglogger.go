@@ -0,0 +1,58 @@
+// Package sloglogger writes logs of the genetic algorithm to log/slog.
+package sloglogger
+
+import (
+	"context"
+	"fmt"
+	. "github.com/WiseBird/genetic_algorithm"
+	"log/slog"
+)
+
+// Slog has no trace level, trace messages are written below debug
+const LevelTrace = slog.LevelDebug - 4
+
+// Adapter of slog logger to LoggerInterface
+type Logger struct {
+	logger *slog.Logger
+}
+
+// Nil logger means slog.Default()
+func New(logger *slog.Logger) *Logger {
+	adapter := new(Logger)
+
+	adapter.logger = logger
+
+	return adapter
+}
+
+func (adapter *Logger) Enabled(level LogLevel) bool {
+	return adapter.slogLogger().Enabled(context.Background(), slogLevel(level))
+}
+func (adapter *Logger) Logf(level LogLevel, format string, args ...interface{}) {
+	logger := adapter.slogLogger()
+	if !logger.Enabled(context.Background(), slogLevel(level)) {
+		return
+	}
+
+	logger.Log(context.Background(), slogLevel(level), fmt.Sprintf(format, args...))
+}
+func (adapter *Logger) slogLogger() *slog.Logger {
+	if adapter.logger == nil {
+		return slog.Default()
+	}
+	return adapter.logger
+}
+
+func slogLevel(level LogLevel) slog.Level {
+	switch level {
+	case LogLevelTrace:
+		return LevelTrace
+	case LogLevelDebug:
+		return slog.LevelDebug
+	case LogLevelInfo:
+		return slog.LevelInfo
+	case LogLevelWarn:
+		return slog.LevelWarn
+	}
+	return slog.LevelError
+}
@@ -16,6 +16,25 @@ type StatisticsInterface interface {
 	Data() StatisticsDataInterface
 }
 
+// Statistics that count cost function evaluations
+type StatisticsWithEvaluationsInterface interface {
+	// Optimizer will call this method each time population cost is set
+	// Skipped are chromosomes which cost remains valid from previous generations
+	OnEvaluations(evaluated, skipped int)
+}
+
+// Statistics that count usage of the cost cache
+type StatisticsWithCostCacheInterface interface {
+	// Optimizer will call this method each time population cost is set using the cache
+	OnCostCache(hits, misses int)
+}
+
+// Statistics of constrained optimization
+type StatisticsWithFeasibilityInterface interface {
+	// Optimizer will call this method on each generation after constrained costs are evaluated
+	OnFeasibility(feasible, total int)
+}
+
 type StatisticsDataInterface interface{}
 
 // Options for statistics
@@ -2,8 +2,8 @@ package genetic_algorithm
 
 import (
 	"bytes"
+	"encoding/gob"
 	"fmt"
-	log "github.com/cihub/seelog"
 	"math"
 	"time"
 )
@@ -20,10 +20,27 @@ type StatisticsDataDefault interface {
 	MeanCosts() []float64
 	WorstCost() float64
 	WorstCosts() []float64
+	Evaluations() int
+	SkippedEvaluations() int
+	CostCacheHits() int
+	CostCacheMisses() int
+	FeasibleFraction() float64
+	FeasibleFractions() []float64
+	GenotypicDiversity() float64
+	GenotypicDiversities() []float64
+	LocusEntropy() []float64
+	MeanLocusEntropy() float64
+	MeanLocusEntropies() []float64
+	CostStdDev() float64
+	CostStdDevs() []float64
+	UniqueGenotypes() int
+	UniqueGenotypesCounts() []int
 }
 
 // Default realization of StatisticsInterface
 type StatisticsDefault struct {
+	logging
+
 	started              bool
 	durationTracker      *durationTracker
 	durationTrackerStack [][]string
@@ -43,13 +60,35 @@ type StatisticsDefault struct {
 	worstCost  float64
 	worstCosts []float64
 
+	evaluations        int
+	skippedEvaluations int
+	evaluationsCounts  []int
+
+	costCacheHits   int
+	costCacheMisses int
+
+	feasibleFraction  float64
+	feasibleFractions []float64
+
+	genotypicDiversity   float64
+	genotypicDiversities []float64
+
+	locusEntropy       []float64
+	meanLocusEntropies []float64
+
+	costStdDev  float64
+	costStdDevs []float64
+
+	uniqueGenotypes       int
+	uniqueGenotypesCounts []int
+
 	options *StatisticsDefaultOptions
 }
 
 func NewStatisticsDefault(options StatisticsOptionsInterface) StatisticsInterface {
 	opts, ok := options.(*StatisticsDefaultOptions)
 	if !ok {
-		panic("Expects instance of StatisticsOptionsInterface")
+		panic(newConfigError("Statistics", "Expects instance of StatisticsDefaultOptions"))
 	}
 
 	statistics := new(StatisticsDefault)
@@ -117,11 +156,15 @@ func (statistics *StatisticsDefault) OnGeneration(population Chromosomes) {
 	}
 
 	statistics.minCost = population[0].Cost()
-	log.Tracef("MinCost %v", statistics.minCost)
+	if statistics.logEnabled(LogLevelTrace) {
+		statistics.tracef("MinCost %v", statistics.minCost)
+	}
 
 	if statistics.options.trackMinCosts {
 		statistics.minCosts = append(statistics.minCosts, population[0].Cost())
-		log.Tracef("MinCosts %v", statistics.minCosts)
+		if statistics.logEnabled(LogLevelTrace) {
+			statistics.tracef("MinCosts %v", statistics.minCosts)
+		}
 	}
 	if statistics.options.trackGensWoImprv {
 		if statistics.generations == 0 || statistics.prevDifferentMinCost != statistics.minCost {
@@ -130,7 +173,9 @@ func (statistics *StatisticsDefault) OnGeneration(population Chromosomes) {
 		} else {
 			statistics.gensWoImprv++
 		}
-		log.Tracef("MinCostAge %v", statistics.gensWoImprv)
+		if statistics.logEnabled(LogLevelTrace) {
+			statistics.tracef("MinCostAge %v", statistics.gensWoImprv)
+		}
 	}
 	if statistics.options.trackMinCostsVar {
 		if statistics.generations == 0 || statistics.generations == statistics.gensWoImprv {
@@ -138,12 +183,16 @@ func (statistics *StatisticsDefault) OnGeneration(population Chromosomes) {
 		} else {
 			statistics.minCostsVar = pvarianceFloat64(statistics.minCosts)
 		}
-		log.Tracef("MinCostsVar %v", statistics.minCostsVar)
+		if statistics.logEnabled(LogLevelTrace) {
+			statistics.tracef("MinCostsVar %v", statistics.minCostsVar)
+		}
 	}
 
 	if statistics.options.trackMeanCost {
 		statistics.meanCost = population.MeanCost()
-		log.Tracef("MeanCost %v", statistics.meanCost)
+		if statistics.logEnabled(LogLevelTrace) {
+			statistics.tracef("MeanCost %v", statistics.meanCost)
+		}
 	}
 	if statistics.options.trackMeanCosts {
 		var mean float64
@@ -154,16 +203,94 @@ func (statistics *StatisticsDefault) OnGeneration(population Chromosomes) {
 		}
 
 		statistics.meanCosts = append(statistics.meanCosts, mean)
-		log.Tracef("MeanCosts %v", statistics.meanCosts)
+		if statistics.logEnabled(LogLevelTrace) {
+			statistics.tracef("MeanCosts %v", statistics.meanCosts)
+		}
 	}
 
 	if statistics.options.trackWorstCost {
 		statistics.worstCost = population[len(population)-1].Cost()
-		log.Tracef("WorstCost %v", statistics.worstCost)
+		if statistics.logEnabled(LogLevelTrace) {
+			statistics.tracef("WorstCost %v", statistics.worstCost)
+		}
 	}
 	if statistics.options.trackWorstCosts {
 		statistics.worstCosts = append(statistics.worstCosts, population[len(population)-1].Cost())
-		log.Tracef("WorstCosts %v", statistics.worstCosts)
+		if statistics.logEnabled(LogLevelTrace) {
+			statistics.tracef("WorstCosts %v", statistics.worstCosts)
+		}
+	}
+
+	if statistics.options.trackEvalsCounts {
+		statistics.evaluationsCounts = append(statistics.evaluationsCounts, statistics.evaluations)
+	}
+
+	statistics.onDiversity(population)
+}
+func (statistics *StatisticsDefault) onDiversity(population Chromosomes) {
+	if statistics.options.trackGenotypicDiversity {
+		statistics.genotypicDiversity = meanPairwiseDistance(population)
+		statistics.genotypicDiversities = append(statistics.genotypicDiversities, statistics.genotypicDiversity)
+		if statistics.logEnabled(LogLevelTrace) {
+			statistics.tracef("GenotypicDiversity %v", statistics.genotypicDiversity)
+		}
+	}
+	if statistics.options.trackLocusEntropy {
+		statistics.locusEntropy = locusEntropy(population)
+		statistics.meanLocusEntropies = append(statistics.meanLocusEntropies, meanFloat64(statistics.locusEntropy))
+		if statistics.logEnabled(LogLevelTrace) {
+			statistics.tracef("LocusEntropy %v", statistics.locusEntropy)
+		}
+	}
+	if statistics.options.trackCostStdDev {
+		statistics.costStdDev = costStdDev(population)
+		statistics.costStdDevs = append(statistics.costStdDevs, statistics.costStdDev)
+		if statistics.logEnabled(LogLevelTrace) {
+			statistics.tracef("CostStdDev %v", statistics.costStdDev)
+		}
+	}
+	if statistics.options.trackUniqueGenotypes {
+		statistics.uniqueGenotypes = uniqueGenotypes(population)
+		statistics.uniqueGenotypesCounts = append(statistics.uniqueGenotypesCounts, statistics.uniqueGenotypes)
+		if statistics.logEnabled(LogLevelTrace) {
+			statistics.tracef("UniqueGenotypes %v", statistics.uniqueGenotypes)
+		}
+	}
+}
+
+func (statistics *StatisticsDefault) OnEvaluations(evaluated, skipped int) {
+	if !statistics.options.trackEvaluations {
+		return
+	}
+
+	statistics.evaluations += evaluated
+	statistics.skippedEvaluations += skipped
+	if statistics.logEnabled(LogLevelTrace) {
+		statistics.tracef("Evaluations %v, skipped %v", statistics.evaluations, statistics.skippedEvaluations)
+	}
+}
+
+func (statistics *StatisticsDefault) OnCostCache(hits, misses int) {
+	if !statistics.options.trackCostCache {
+		return
+	}
+
+	statistics.costCacheHits += hits
+	statistics.costCacheMisses += misses
+	if statistics.logEnabled(LogLevelTrace) {
+		statistics.tracef("CostCache hits %v, misses %v", statistics.costCacheHits, statistics.costCacheMisses)
+	}
+}
+
+func (statistics *StatisticsDefault) OnFeasibility(feasible, total int) {
+	if !statistics.options.trackFeasibility {
+		return
+	}
+
+	statistics.feasibleFraction = float64(feasible) / float64(total)
+	statistics.feasibleFractions = append(statistics.feasibleFractions, statistics.feasibleFraction)
+	if statistics.logEnabled(LogLevelTrace) {
+		statistics.tracef("FeasibleFraction %v", statistics.feasibleFraction)
 	}
 }
 
@@ -187,7 +314,11 @@ func (statistics *StatisticsDefault) Duration() time.Duration {
 }
 
 // Returns entire tracked hierarchy of duration
+// Nil if durations aren't tracked
 func (statistics *StatisticsDefault) Durations() *HierarchicalDuration {
+	if statistics.durationTracker == nil {
+		return nil
+	}
 	if statistics.hierarchy == nil {
 		statistics.hierarchy = statistics.durationTracker.toHierarchy()
 	}
@@ -239,10 +370,220 @@ func (statistics *StatisticsDefault) WorstCosts() []float64 {
 	return statistics.worstCosts
 }
 
+// Total number of cost function evaluations
+func (statistics *StatisticsDefault) Evaluations() int {
+	return statistics.evaluations
+}
+
+// Number of evaluations saved because chromosomes weren't changed
+func (statistics *StatisticsDefault) SkippedEvaluations() int {
+	return statistics.skippedEvaluations
+}
+
+// Number of evaluations made until each generation, inclusive
+// Len would be `Generations() + 1` because of initial value
+func (statistics *StatisticsDefault) EvaluationsCounts() []int {
+	return statistics.evaluationsCounts
+}
+
+// Number of evaluations made until min cost reached the target for the first time.
+// Requires tracking of min costs and evaluations counts. False if the target wasn't reached.
+func (statistics *StatisticsDefault) EvaluationsToTarget(target float64) (int, bool) {
+	return evaluationsToTarget(statistics.minCosts, statistics.evaluationsCounts, target)
+}
+func evaluationsToTarget(minCosts []float64, evaluationsCounts []int, target float64) (int, bool) {
+	for i, cost := range minCosts {
+		if cost <= target && i < len(evaluationsCounts) {
+			return evaluationsCounts[i], true
+		}
+	}
+	return 0, false
+}
+
+// Number of costs taken from the cost cache
+func (statistics *StatisticsDefault) CostCacheHits() int {
+	return statistics.costCacheHits
+}
+
+// Number of costs evaluated because they were missing in the cost cache
+func (statistics *StatisticsDefault) CostCacheMisses() int {
+	return statistics.costCacheMisses
+}
+
+// Fraction of feasible chromosomes in the last population
+func (statistics *StatisticsDefault) FeasibleFraction() float64 {
+	return statistics.feasibleFraction
+}
+
+// Fraction of feasible chromosomes in each population
+// Len would be `Generations() + 1` because of initial value
+func (statistics *StatisticsDefault) FeasibleFractions() []float64 {
+	return statistics.feasibleFractions
+}
+
+// Mean pairwise distance between genotypes of the last population
+// NaN if genes don't implement GenesDistanceInterface
+func (statistics *StatisticsDefault) GenotypicDiversity() float64 {
+	return statistics.genotypicDiversity
+}
+
+// Mean pairwise distance between genotypes of each population
+// Len would be `Generations() + 1` because of initial value
+func (statistics *StatisticsDefault) GenotypicDiversities() []float64 {
+	return statistics.genotypicDiversities
+}
+
+// Entropy of gene values at each locus of the last population
+func (statistics *StatisticsDefault) LocusEntropy() []float64 {
+	return statistics.locusEntropy
+}
+
+// Mean entropy of loci of the last population
+func (statistics *StatisticsDefault) MeanLocusEntropy() float64 {
+	return meanFloat64(statistics.locusEntropy)
+}
+
+// Mean entropy of loci of each population
+// Len would be `Generations() + 1` because of initial value
+func (statistics *StatisticsDefault) MeanLocusEntropies() []float64 {
+	return statistics.meanLocusEntropies
+}
+
+// Standard deviation of costs of the last population
+func (statistics *StatisticsDefault) CostStdDev() float64 {
+	return statistics.costStdDev
+}
+
+// Standard deviation of costs of each population
+// Len would be `Generations() + 1` because of initial value
+func (statistics *StatisticsDefault) CostStdDevs() []float64 {
+	return statistics.costStdDevs
+}
+
+// Number of different genotypes in the last population
+func (statistics *StatisticsDefault) UniqueGenotypes() int {
+	return statistics.uniqueGenotypes
+}
+
+// Number of different genotypes in each population
+// Len would be `Generations() + 1` because of initial value
+func (statistics *StatisticsDefault) UniqueGenotypesCounts() []int {
+	return statistics.uniqueGenotypesCounts
+}
+
 func (statistics *StatisticsDefault) Data() StatisticsDataInterface {
 	return statistics
 }
 
+type statisticsDefaultCheckpoint struct {
+	Generations int
+
+	MinCost              float64
+	GensWoImprv          int
+	PrevDifferentMinCost float64
+	MinCosts             []float64
+	MinCostsVar          float64
+
+	MeanCost  float64
+	MeanCosts []float64
+
+	WorstCost  float64
+	WorstCosts []float64
+
+	Evaluations        int
+	SkippedEvaluations int
+	EvaluationsCounts  []int
+
+	CostCacheHits   int
+	CostCacheMisses int
+
+	FeasibleFraction  float64
+	FeasibleFractions []float64
+
+	GenotypicDiversity   float64
+	GenotypicDiversities []float64
+
+	LocusEntropy       []float64
+	MeanLocusEntropies []float64
+
+	CostStdDev  float64
+	CostStdDevs []float64
+
+	UniqueGenotypes       int
+	UniqueGenotypesCounts []int
+}
+
+// Saves tracked series and counters. Durations aren't saved.
+func (statistics *StatisticsDefault) MarshalCheckpoint() ([]byte, error) {
+	checkpoint := statisticsDefaultCheckpoint{
+		statistics.generations,
+		statistics.minCost,
+		statistics.gensWoImprv,
+		statistics.prevDifferentMinCost,
+		statistics.minCosts,
+		statistics.minCostsVar,
+		statistics.meanCost,
+		statistics.meanCosts,
+		statistics.worstCost,
+		statistics.worstCosts,
+		statistics.evaluations,
+		statistics.skippedEvaluations,
+		statistics.evaluationsCounts,
+		statistics.costCacheHits,
+		statistics.costCacheMisses,
+		statistics.feasibleFraction,
+		statistics.feasibleFractions,
+		statistics.genotypicDiversity,
+		statistics.genotypicDiversities,
+		statistics.locusEntropy,
+		statistics.meanLocusEntropies,
+		statistics.costStdDev,
+		statistics.costStdDevs,
+		statistics.uniqueGenotypes,
+		statistics.uniqueGenotypesCounts,
+	}
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(checkpoint); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+func (statistics *StatisticsDefault) UnmarshalCheckpoint(data []byte) error {
+	var checkpoint statisticsDefaultCheckpoint
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&checkpoint); err != nil {
+		return err
+	}
+
+	statistics.generations = checkpoint.Generations
+	statistics.minCost = checkpoint.MinCost
+	statistics.gensWoImprv = checkpoint.GensWoImprv
+	statistics.prevDifferentMinCost = checkpoint.PrevDifferentMinCost
+	statistics.minCosts = checkpoint.MinCosts
+	statistics.minCostsVar = checkpoint.MinCostsVar
+	statistics.meanCost = checkpoint.MeanCost
+	statistics.meanCosts = checkpoint.MeanCosts
+	statistics.worstCost = checkpoint.WorstCost
+	statistics.worstCosts = checkpoint.WorstCosts
+	statistics.evaluations = checkpoint.Evaluations
+	statistics.skippedEvaluations = checkpoint.SkippedEvaluations
+	statistics.evaluationsCounts = checkpoint.EvaluationsCounts
+	statistics.costCacheHits = checkpoint.CostCacheHits
+	statistics.costCacheMisses = checkpoint.CostCacheMisses
+	statistics.feasibleFraction = checkpoint.FeasibleFraction
+	statistics.feasibleFractions = checkpoint.FeasibleFractions
+	statistics.genotypicDiversity = checkpoint.GenotypicDiversity
+	statistics.genotypicDiversities = checkpoint.GenotypicDiversities
+	statistics.locusEntropy = checkpoint.LocusEntropy
+	statistics.meanLocusEntropies = checkpoint.MeanLocusEntropies
+	statistics.costStdDev = checkpoint.CostStdDev
+	statistics.costStdDevs = checkpoint.CostStdDevs
+	statistics.uniqueGenotypes = checkpoint.UniqueGenotypes
+	statistics.uniqueGenotypesCounts = checkpoint.UniqueGenotypesCounts
+
+	return nil
+}
+
 type durationTracker struct {
 	startTime time.Time
 	elapsed   []time.Duration
@@ -1,9 +1,31 @@
 package genetic_algorithm
 
 import (
+	"math"
+	"sort"
 	"time"
 )
 
+// Distributions across runs, computed by StatisticsDefaultAggregator in addition to means
+type StatisticsDataAggregated interface {
+	StatisticsDataDefault
+
+	Runs() int
+	RunsAt(generation int) int
+	RunsStatistics() []StatisticsDataDefault
+	MinCostsMedians() []float64
+	MinCostsQuartiles() (lower, upper []float64)
+	MinCostsEnvelope() (lower, upper []float64)
+	MinCostsConfidenceIntervals() (lower, upper []float64)
+	FinalMinCosts() []float64
+	FinalMinCostQuantile(q float64) float64
+	SuccessRate(target float64) float64
+	MeanEvaluationsToTarget(target float64) float64
+}
+
+// Means of runs' statistics.
+// Series of runs which stopped earlier are padded with their last value for means,
+// per-generation distributions are computed only over runs which reached the generation.
 type StatisticsDefaultAggregator struct {
 	options    *StatisticsDefaultOptions
 	statistics []*StatisticsDefault
@@ -18,17 +40,47 @@ type StatisticsDefaultAggregator struct {
 	minCosts    []float64
 	minCostsVar float64
 
+	minCostsMedians        []float64
+	minCostsLowerQuartiles []float64
+	minCostsUpperQuartiles []float64
+	minCostsMinimums       []float64
+	minCostsMaximums       []float64
+	minCostsLowerCIs       []float64
+	minCostsUpperCIs       []float64
+	finalMinCosts          []float64
+
 	meanCost  float64
 	meanCosts []float64
 
 	worstCost  float64
 	worstCosts []float64
+
+	evaluations        int
+	skippedEvaluations int
+
+	costCacheHits   int
+	costCacheMisses int
+
+	feasibleFraction  float64
+	feasibleFractions []float64
+
+	genotypicDiversity   float64
+	genotypicDiversities []float64
+
+	locusEntropy       []float64
+	meanLocusEntropies []float64
+
+	costStdDev  float64
+	costStdDevs []float64
+
+	uniqueGenotypes       int
+	uniqueGenotypesCounts []int
 }
 
 func NewStatisticsDefaultAggregator(options StatisticsOptionsInterface) StatisticsAggregatorInterface {
 	opts, ok := options.(*StatisticsDefaultOptions)
 	if !ok {
-		panic("Expects instance of StatisticsOptionsInterface")
+		panic(newConfigError("Statistics", "Expects instance of StatisticsDefaultOptions"))
 	}
 
 	aggregator := new(StatisticsDefaultAggregator)
@@ -60,6 +112,7 @@ func (aggregator *StatisticsDefaultAggregator) Compute() StatisticsDataInterface
 		meanFloat64Iter(count, func(i int) float64 {
 			return aggregator.statistics[i].minCost
 		})
+	aggregator.computeFinalMinCosts()
 
 	if aggregator.options.trackDurations {
 		aggregator.duration = time.Duration(
@@ -75,6 +128,7 @@ func (aggregator *StatisticsDefaultAggregator) Compute() StatisticsDataInterface
 			meanFloat64ArrIter(count, func(i int) []float64 {
 				return aggregator.statistics[i].minCosts
 			})
+		aggregator.computeMinCostsDistributions()
 	}
 	if aggregator.options.trackGensWoImprv {
 		aggregator.gensWoImprv = int(
@@ -115,8 +169,124 @@ func (aggregator *StatisticsDefaultAggregator) Compute() StatisticsDataInterface
 			})
 	}
 
+	if aggregator.options.trackEvaluations {
+		aggregator.evaluations = int(
+			meanInt64Iter(count, func(i int) int64 {
+				return int64(aggregator.statistics[i].evaluations)
+			}))
+		aggregator.skippedEvaluations = int(
+			meanInt64Iter(count, func(i int) int64 {
+				return int64(aggregator.statistics[i].skippedEvaluations)
+			}))
+	}
+
+	if aggregator.options.trackCostCache {
+		aggregator.costCacheHits = int(
+			meanInt64Iter(count, func(i int) int64 {
+				return int64(aggregator.statistics[i].costCacheHits)
+			}))
+		aggregator.costCacheMisses = int(
+			meanInt64Iter(count, func(i int) int64 {
+				return int64(aggregator.statistics[i].costCacheMisses)
+			}))
+	}
+
+	if aggregator.options.trackFeasibility {
+		aggregator.feasibleFraction =
+			meanFloat64Iter(count, func(i int) float64 {
+				return aggregator.statistics[i].feasibleFraction
+			})
+		aggregator.feasibleFractions =
+			meanFloat64ArrIter(count, func(i int) []float64 {
+				return aggregator.statistics[i].feasibleFractions
+			})
+	}
+
+	if aggregator.options.trackGenotypicDiversity {
+		aggregator.genotypicDiversity =
+			meanFloat64Iter(count, func(i int) float64 {
+				return aggregator.statistics[i].genotypicDiversity
+			})
+		aggregator.genotypicDiversities =
+			meanFloat64ArrIter(count, func(i int) []float64 {
+				return aggregator.statistics[i].genotypicDiversities
+			})
+	}
+	if aggregator.options.trackLocusEntropy {
+		aggregator.locusEntropy =
+			meanFloat64ArrIter(count, func(i int) []float64 {
+				return aggregator.statistics[i].locusEntropy
+			})
+		aggregator.meanLocusEntropies =
+			meanFloat64ArrIter(count, func(i int) []float64 {
+				return aggregator.statistics[i].meanLocusEntropies
+			})
+	}
+	if aggregator.options.trackCostStdDev {
+		aggregator.costStdDev =
+			meanFloat64Iter(count, func(i int) float64 {
+				return aggregator.statistics[i].costStdDev
+			})
+		aggregator.costStdDevs =
+			meanFloat64ArrIter(count, func(i int) []float64 {
+				return aggregator.statistics[i].costStdDevs
+			})
+	}
+	if aggregator.options.trackUniqueGenotypes {
+		aggregator.uniqueGenotypes = int(
+			meanInt64Iter(count, func(i int) int64 {
+				return int64(aggregator.statistics[i].uniqueGenotypes)
+			}))
+
+		meanCounts := meanFloat64ArrIter(count, func(i int) []float64 {
+			counts := aggregator.statistics[i].uniqueGenotypesCounts
+			values := make([]float64, len(counts))
+			for j, count := range counts {
+				values[j] = float64(count)
+			}
+			return values
+		})
+		aggregator.uniqueGenotypesCounts = make([]int, len(meanCounts))
+		for i, mean := range meanCounts {
+			aggregator.uniqueGenotypesCounts[i] = round(mean)
+		}
+	}
+
 	return aggregator
 }
+func (aggregator *StatisticsDefaultAggregator) computeFinalMinCosts() {
+	aggregator.finalMinCosts = make([]float64, len(aggregator.statistics))
+	for i, stats := range aggregator.statistics {
+		aggregator.finalMinCosts[i] = stats.minCost
+	}
+	sort.Float64s(aggregator.finalMinCosts)
+}
+func (aggregator *StatisticsDefaultAggregator) computeMinCostsDistributions() {
+	series := make([][]float64, len(aggregator.statistics))
+	for i, stats := range aggregator.statistics {
+		series[i] = stats.minCosts
+	}
+	columns := columnsFloat64Arr(series)
+
+	aggregator.minCostsMedians = make([]float64, len(columns))
+	aggregator.minCostsLowerQuartiles = make([]float64, len(columns))
+	aggregator.minCostsUpperQuartiles = make([]float64, len(columns))
+	aggregator.minCostsMinimums = make([]float64, len(columns))
+	aggregator.minCostsMaximums = make([]float64, len(columns))
+	aggregator.minCostsLowerCIs = make([]float64, len(columns))
+	aggregator.minCostsUpperCIs = make([]float64, len(columns))
+
+	for i, column := range columns {
+		aggregator.minCostsLowerCIs[i], aggregator.minCostsUpperCIs[i] = confidenceInterval95(column)
+
+		sort.Float64s(column)
+		aggregator.minCostsMedians[i] = quantileFloat64(column, 0.5)
+		aggregator.minCostsLowerQuartiles[i] = quantileFloat64(column, 0.25)
+		aggregator.minCostsUpperQuartiles[i] = quantileFloat64(column, 0.75)
+		aggregator.minCostsMinimums[i] = column[0]
+		aggregator.minCostsMaximums[i] = column[len(column)-1]
+	}
+}
 func (aggregator *StatisticsDefaultAggregator) computeDurations(keys []string) *HierarchicalDuration {
 	count := len(aggregator.statistics)
 
@@ -180,6 +350,115 @@ func (aggregator *StatisticsDefaultAggregator) MinCosts() []float64 {
 func (aggregator *StatisticsDefaultAggregator) MinCostsVar() float64 {
 	return aggregator.minCostsVar
 }
+
+// Number of aggregated runs
+func (aggregator *StatisticsDefaultAggregator) Runs() int {
+	return len(aggregator.statistics)
+}
+
+// Number of runs which reached the generation, per-generation distributions are computed over them
+func (aggregator *StatisticsDefaultAggregator) RunsAt(generation int) int {
+	return runsAt(aggregator.RunsStatistics(), generation)
+}
+
+// Statistics of each aggregated run in order of aggregation
+func (aggregator *StatisticsDefaultAggregator) RunsStatistics() []StatisticsDataDefault {
+	runs := make([]StatisticsDataDefault, len(aggregator.statistics))
+	for i, stats := range aggregator.statistics {
+		runs[i] = stats
+	}
+	return runs
+}
+
+// Per-generation median of runs' min costs
+func (aggregator *StatisticsDefaultAggregator) MinCostsMedians() []float64 {
+	return aggregator.minCostsMedians
+}
+
+// Per-generation first and third quartiles of runs' min costs
+func (aggregator *StatisticsDefaultAggregator) MinCostsQuartiles() (lower, upper []float64) {
+	return aggregator.minCostsLowerQuartiles, aggregator.minCostsUpperQuartiles
+}
+
+// Per-generation minimum and maximum of runs' min costs
+func (aggregator *StatisticsDefaultAggregator) MinCostsEnvelope() (lower, upper []float64) {
+	return aggregator.minCostsMinimums, aggregator.minCostsMaximums
+}
+
+// Per-generation 95% confidence interval of the mean of runs' min costs
+func (aggregator *StatisticsDefaultAggregator) MinCostsConfidenceIntervals() (lower, upper []float64) {
+	return aggregator.minCostsLowerCIs, aggregator.minCostsUpperCIs
+}
+
+// Final min cost of each run in ascending order
+func (aggregator *StatisticsDefaultAggregator) FinalMinCosts() []float64 {
+	return aggregator.finalMinCosts
+}
+
+// Quantile of runs' final min costs, e.g. 0.5 for the median
+func (aggregator *StatisticsDefaultAggregator) FinalMinCostQuantile(q float64) float64 {
+	return quantileFloat64(aggregator.finalMinCosts, q)
+}
+
+// Fraction of runs which reached target cost
+func (aggregator *StatisticsDefaultAggregator) SuccessRate(target float64) float64 {
+	return successRate(aggregator.finalMinCosts, target)
+}
+
+// Mean number of evaluations until target cost was first reached, over successful runs as in SuccessRate.
+// Requires tracking of min costs and evaluations counts. NaN if no run was successful.
+func (aggregator *StatisticsDefaultAggregator) MeanEvaluationsToTarget(target float64) float64 {
+	return meanEvaluationsToTarget(aggregator.RunsStatistics(), target)
+}
+
+// Fraction of final min costs, sorted in ascending order, which reached target cost
+func successRate(finalMinCosts []float64, target float64) float64 {
+	if len(finalMinCosts) == 0 {
+		return 0
+	}
+
+	reached := sort.Search(len(finalMinCosts), func(i int) bool {
+		return finalMinCosts[i] > target
+	})
+	return float64(reached) / float64(len(finalMinCosts))
+}
+
+// Generations are numbered from 0, the initial population
+func runsAt(runs []StatisticsDataDefault, generation int) int {
+	count := 0
+	for _, stats := range runs {
+		if stats.Generations() >= generation {
+			count++
+		}
+	}
+	return count
+}
+
+// Runs which don't count evaluations are skipped
+func meanEvaluationsToTarget(runs []StatisticsDataDefault, target float64) float64 {
+	var evaluations []float64
+	for _, stats := range runs {
+		if stats.MinCost() > target {
+			continue
+		}
+
+		counted, ok := stats.(interface {
+			EvaluationsToTarget(target float64) (int, bool)
+		})
+		if !ok {
+			continue
+		}
+		if count, ok := counted.EvaluationsToTarget(target); ok {
+			evaluations = append(evaluations, float64(count))
+		}
+	}
+
+	if len(evaluations) == 0 {
+		return math.NaN()
+	}
+	return meanFloat64(evaluations)
+}
+
 func (aggregator *StatisticsDefaultAggregator) MeanCost() float64 {
 	return aggregator.meanCost
 }
@@ -192,6 +471,51 @@ func (aggregator *StatisticsDefaultAggregator) WorstCost() float64 {
 func (aggregator *StatisticsDefaultAggregator) WorstCosts() []float64 {
 	return aggregator.worstCosts
 }
+func (aggregator *StatisticsDefaultAggregator) Evaluations() int {
+	return aggregator.evaluations
+}
+func (aggregator *StatisticsDefaultAggregator) SkippedEvaluations() int {
+	return aggregator.skippedEvaluations
+}
+func (aggregator *StatisticsDefaultAggregator) CostCacheHits() int {
+	return aggregator.costCacheHits
+}
+func (aggregator *StatisticsDefaultAggregator) CostCacheMisses() int {
+	return aggregator.costCacheMisses
+}
+func (aggregator *StatisticsDefaultAggregator) FeasibleFraction() float64 {
+	return aggregator.feasibleFraction
+}
+func (aggregator *StatisticsDefaultAggregator) FeasibleFractions() []float64 {
+	return aggregator.feasibleFractions
+}
+func (aggregator *StatisticsDefaultAggregator) GenotypicDiversity() float64 {
+	return aggregator.genotypicDiversity
+}
+func (aggregator *StatisticsDefaultAggregator) GenotypicDiversities() []float64 {
+	return aggregator.genotypicDiversities
+}
+func (aggregator *StatisticsDefaultAggregator) LocusEntropy() []float64 {
+	return aggregator.locusEntropy
+}
+func (aggregator *StatisticsDefaultAggregator) MeanLocusEntropy() float64 {
+	return meanFloat64(aggregator.locusEntropy)
+}
+func (aggregator *StatisticsDefaultAggregator) MeanLocusEntropies() []float64 {
+	return aggregator.meanLocusEntropies
+}
+func (aggregator *StatisticsDefaultAggregator) CostStdDev() float64 {
+	return aggregator.costStdDev
+}
+func (aggregator *StatisticsDefaultAggregator) CostStdDevs() []float64 {
+	return aggregator.costStdDevs
+}
+func (aggregator *StatisticsDefaultAggregator) UniqueGenotypes() int {
+	return aggregator.uniqueGenotypes
+}
+func (aggregator *StatisticsDefaultAggregator) UniqueGenotypesCounts() []int {
+	return aggregator.uniqueGenotypesCounts
+}
 
 func (aggregator *StatisticsDefaultAggregator) Data() StatisticsDataInterface {
 	return aggregator
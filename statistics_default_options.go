@@ -10,6 +10,15 @@ type StatisticsDefaultOptions struct {
 	trackWorstCosts  bool
 	trackMinCostsVar bool
 	trackDurations   bool
+	trackEvaluations bool
+	trackEvalsCounts bool
+	trackCostCache   bool
+	trackFeasibility bool
+
+	trackGenotypicDiversity bool
+	trackLocusEntropy       bool
+	trackCostStdDev         bool
+	trackUniqueGenotypes    bool
 }
 
 func NewStatisticsDefaultOptions() *StatisticsDefaultOptions {
@@ -48,6 +57,53 @@ func (options *StatisticsDefaultOptions) TrackDurations() *StatisticsDefaultOpti
 	options.trackDurations = true
 	return options
 }
+func (options *StatisticsDefaultOptions) TrackEvaluations() *StatisticsDefaultOptions {
+	options.trackEvaluations = true
+	return options
+}
+
+// Number of evaluations made so far, tracked for each generation
+func (options *StatisticsDefaultOptions) TrackEvaluationsCounts() *StatisticsDefaultOptions {
+	options.TrackEvaluations()
+	options.trackEvalsCounts = true
+	return options
+}
+func (options *StatisticsDefaultOptions) TrackCostCache() *StatisticsDefaultOptions {
+	options.trackCostCache = true
+	return options
+}
+
+// Fraction of feasible chromosomes, tracked for each generation
+func (options *StatisticsDefaultOptions) TrackFeasibility() *StatisticsDefaultOptions {
+	options.trackFeasibility = true
+	return options
+}
+
+// Mean pairwise distance between genotypes, tracked for each generation.
+// Genes must implement GenesDistanceInterface, which all built-in genes do: Hamming distance for binary genes,
+// Kendall tau for ordered ones, Euclidean for real ones and Manhattan for integer ones.
+func (options *StatisticsDefaultOptions) TrackGenotypicDiversity() *StatisticsDefaultOptions {
+	options.trackGenotypicDiversity = true
+	return options
+}
+
+// Entropy of gene values at each locus, mean entropy is tracked for each generation
+func (options *StatisticsDefaultOptions) TrackLocusEntropy() *StatisticsDefaultOptions {
+	options.trackLocusEntropy = true
+	return options
+}
+
+// Standard deviation of costs, tracked for each generation
+func (options *StatisticsDefaultOptions) TrackCostStdDev() *StatisticsDefaultOptions {
+	options.trackCostStdDev = true
+	return options
+}
+
+// Number of different genotypes, tracked for each generation
+func (options *StatisticsDefaultOptions) TrackUniqueGenotypes() *StatisticsDefaultOptions {
+	options.trackUniqueGenotypes = true
+	return options
+}
 
 func (options *StatisticsDefaultOptions) Ensure(other StatisticsOptionsInterface) {
 	opt, ok := other.(*StatisticsDefaultOptions)
@@ -79,6 +135,30 @@ func (options *StatisticsDefaultOptions) Ensure(other StatisticsOptionsInterface
 	if options.trackDurations {
 		opt.TrackDurations()
 	}
+	if options.trackEvaluations {
+		opt.TrackEvaluations()
+	}
+	if options.trackEvalsCounts {
+		opt.TrackEvaluationsCounts()
+	}
+	if options.trackCostCache {
+		opt.TrackCostCache()
+	}
+	if options.trackFeasibility {
+		opt.TrackFeasibility()
+	}
+	if options.trackGenotypicDiversity {
+		opt.TrackGenotypicDiversity()
+	}
+	if options.trackLocusEntropy {
+		opt.TrackLocusEntropy()
+	}
+	if options.trackCostStdDev {
+		opt.TrackCostStdDev()
+	}
+	if options.trackUniqueGenotypes {
+		opt.TrackUniqueGenotypes()
+	}
 }
 func (options *StatisticsDefaultOptions) Copy() *StatisticsDefaultOptions {
 	return &StatisticsDefaultOptions{
@@ -90,5 +170,13 @@ func (options *StatisticsDefaultOptions) Copy() *StatisticsDefaultOptions {
 		options.trackWorstCosts,
 		options.trackMinCostsVar,
 		options.trackDurations,
+		options.trackEvaluations,
+		options.trackEvalsCounts,
+		options.trackCostCache,
+		options.trackFeasibility,
+		options.trackGenotypicDiversity,
+		options.trackLocusEntropy,
+		options.trackCostStdDev,
+		options.trackUniqueGenotypes,
 	}
 }
@@ -0,0 +1,426 @@
+package genetic_algorithm
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"strconv"
+	"time"
+)
+
+// Writes statistics of a run or computed statistics of an aggregator as JSON.
+// Statistics must implement StatisticsDataDefault. Distributions of StatisticsDataAggregated
+// are written along with statistics of each run.
+func EncodeStatisticsJSON(w io.Writer, data StatisticsDataInterface) error {
+	stats, ok := data.(StatisticsDataDefault)
+	if !ok {
+		return errors.New("Expects StatisticsDataDefault")
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(newStatisticsDocument(stats))
+}
+
+// Reads statistics written by EncodeStatisticsJSON.
+// Result can be passed wherever StatisticsDataDefault is expected, e.g. to plotting.
+// Statistics of an aggregator are read as StatisticsDataAggregated.
+func DecodeStatisticsJSON(r io.Reader) (StatisticsDataDefault, error) {
+	document := new(statisticsDocument)
+	if err := json.NewDecoder(r).Decode(document); err != nil {
+		return nil, err
+	}
+	return newDecodedStatistics(document), nil
+}
+
+// Writes per-generation series as CSV, one row per generation.
+// Only tracked series are written, the first column is the generation number.
+func EncodeStatisticsCSV(w io.Writer, data StatisticsDataInterface) error {
+	stats, ok := data.(StatisticsDataDefault)
+	if !ok {
+		return errors.New("Expects StatisticsDataDefault")
+	}
+
+	uniqueGenotypes := make([]float64, len(stats.UniqueGenotypesCounts()))
+	for i, count := range stats.UniqueGenotypesCounts() {
+		uniqueGenotypes[i] = float64(count)
+	}
+
+	allSeries := []struct {
+		name   string
+		values []float64
+	}{
+		{"min_cost", stats.MinCosts()},
+		{"mean_cost", stats.MeanCosts()},
+		{"worst_cost", stats.WorstCosts()},
+		{"feasible_fraction", stats.FeasibleFractions()},
+		{"genotypic_diversity", stats.GenotypicDiversities()},
+		{"mean_locus_entropy", stats.MeanLocusEntropies()},
+		{"cost_std_dev", stats.CostStdDevs()},
+		{"unique_genotypes", uniqueGenotypes},
+	}
+
+	header := []string{"generation"}
+	var columns [][]float64
+	rows := 0
+	for _, series := range allSeries {
+		if len(series.values) == 0 {
+			continue
+		}
+
+		header = append(header, series.name)
+		columns = append(columns, series.values)
+		if len(series.values) > rows {
+			rows = len(series.values)
+		}
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	record := make([]string, len(header))
+	for row := 0; row < rows; row++ {
+		record[0] = strconv.Itoa(row)
+		for i, column := range columns {
+			record[i+1] = ""
+			if row < len(column) {
+				record[i+1] = strconv.FormatFloat(column[row], 'g', -1, 64)
+			}
+		}
+
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// JSON representation of StatisticsDataDefault
+type statisticsDocument struct {
+	Generations int                   `json:"generations"`
+	Duration    time.Duration         `json:"duration"`
+	Durations   *HierarchicalDuration `json:"durations,omitempty"`
+
+	MinCost                        jsonFloat   `json:"minCost"`
+	MinCosts                       []jsonFloat `json:"minCosts,omitempty"`
+	GenerationsWithoutImprovements int         `json:"generationsWithoutImprovements"`
+	MinCostsVar                    jsonFloat   `json:"minCostsVar"`
+
+	MeanCost  jsonFloat   `json:"meanCost"`
+	MeanCosts []jsonFloat `json:"meanCosts,omitempty"`
+
+	WorstCost  jsonFloat   `json:"worstCost"`
+	WorstCosts []jsonFloat `json:"worstCosts,omitempty"`
+
+	Evaluations        int `json:"evaluations"`
+	SkippedEvaluations int `json:"skippedEvaluations"`
+	CostCacheHits      int `json:"costCacheHits"`
+	CostCacheMisses    int `json:"costCacheMisses"`
+
+	FeasibleFraction  jsonFloat   `json:"feasibleFraction"`
+	FeasibleFractions []jsonFloat `json:"feasibleFractions,omitempty"`
+
+	GenotypicDiversity   jsonFloat   `json:"genotypicDiversity"`
+	GenotypicDiversities []jsonFloat `json:"genotypicDiversities,omitempty"`
+
+	LocusEntropy       []jsonFloat `json:"locusEntropy,omitempty"`
+	MeanLocusEntropies []jsonFloat `json:"meanLocusEntropies,omitempty"`
+
+	CostStdDev  jsonFloat   `json:"costStdDev"`
+	CostStdDevs []jsonFloat `json:"costStdDevs,omitempty"`
+
+	UniqueGenotypes       int   `json:"uniqueGenotypes"`
+	UniqueGenotypesCounts []int `json:"uniqueGenotypesCounts,omitempty"`
+
+	EvaluationsCounts []int `json:"evaluationsCounts,omitempty"`
+
+	Aggregated *aggregatedDocument `json:"aggregated,omitempty"`
+}
+
+// JSON representation of distributions of StatisticsDataAggregated
+type aggregatedDocument struct {
+	Runs []*statisticsDocument `json:"runs"`
+
+	MinCostsMedians        []jsonFloat `json:"minCostsMedians,omitempty"`
+	MinCostsLowerQuartiles []jsonFloat `json:"minCostsLowerQuartiles,omitempty"`
+	MinCostsUpperQuartiles []jsonFloat `json:"minCostsUpperQuartiles,omitempty"`
+	MinCostsMinimums       []jsonFloat `json:"minCostsMinimums,omitempty"`
+	MinCostsMaximums       []jsonFloat `json:"minCostsMaximums,omitempty"`
+	MinCostsLowerCIs       []jsonFloat `json:"minCostsLowerCIs,omitempty"`
+	MinCostsUpperCIs       []jsonFloat `json:"minCostsUpperCIs,omitempty"`
+
+	FinalMinCosts []jsonFloat `json:"finalMinCosts"`
+}
+
+func newStatisticsDocument(stats StatisticsDataDefault) *statisticsDocument {
+	document := &statisticsDocument{
+		Generations: stats.Generations(),
+		Duration:    stats.Duration(),
+		Durations:   stats.Durations(),
+
+		MinCost:                        jsonFloat(stats.MinCost()),
+		MinCosts:                       toJSONFloats(stats.MinCosts()),
+		GenerationsWithoutImprovements: stats.GenerationsWithoutImprovements(),
+		MinCostsVar:                    jsonFloat(stats.MinCostsVar()),
+
+		MeanCost:  jsonFloat(stats.MeanCost()),
+		MeanCosts: toJSONFloats(stats.MeanCosts()),
+
+		WorstCost:  jsonFloat(stats.WorstCost()),
+		WorstCosts: toJSONFloats(stats.WorstCosts()),
+
+		Evaluations:        stats.Evaluations(),
+		SkippedEvaluations: stats.SkippedEvaluations(),
+		CostCacheHits:      stats.CostCacheHits(),
+		CostCacheMisses:    stats.CostCacheMisses(),
+
+		FeasibleFraction:  jsonFloat(stats.FeasibleFraction()),
+		FeasibleFractions: toJSONFloats(stats.FeasibleFractions()),
+
+		GenotypicDiversity:   jsonFloat(stats.GenotypicDiversity()),
+		GenotypicDiversities: toJSONFloats(stats.GenotypicDiversities()),
+
+		LocusEntropy:       toJSONFloats(stats.LocusEntropy()),
+		MeanLocusEntropies: toJSONFloats(stats.MeanLocusEntropies()),
+
+		CostStdDev:  jsonFloat(stats.CostStdDev()),
+		CostStdDevs: toJSONFloats(stats.CostStdDevs()),
+
+		UniqueGenotypes:       stats.UniqueGenotypes(),
+		UniqueGenotypesCounts: stats.UniqueGenotypesCounts(),
+	}
+
+	if counted, ok := stats.(interface{ EvaluationsCounts() []int }); ok {
+		document.EvaluationsCounts = counted.EvaluationsCounts()
+	}
+	if aggregated, ok := stats.(StatisticsDataAggregated); ok {
+		document.Aggregated = newAggregatedDocument(aggregated)
+	}
+
+	return document
+}
+func newAggregatedDocument(stats StatisticsDataAggregated) *aggregatedDocument {
+	document := &aggregatedDocument{
+		MinCostsMedians: toJSONFloats(stats.MinCostsMedians()),
+		FinalMinCosts:   toJSONFloats(stats.FinalMinCosts()),
+	}
+
+	lower, upper := stats.MinCostsQuartiles()
+	document.MinCostsLowerQuartiles, document.MinCostsUpperQuartiles = toJSONFloats(lower), toJSONFloats(upper)
+	lower, upper = stats.MinCostsEnvelope()
+	document.MinCostsMinimums, document.MinCostsMaximums = toJSONFloats(lower), toJSONFloats(upper)
+	lower, upper = stats.MinCostsConfidenceIntervals()
+	document.MinCostsLowerCIs, document.MinCostsUpperCIs = toJSONFloats(lower), toJSONFloats(upper)
+
+	for _, run := range stats.RunsStatistics() {
+		document.Runs = append(document.Runs, newStatisticsDocument(run))
+	}
+
+	return document
+}
+
+// Statistics read from JSON
+type decodedStatistics struct {
+	document statisticsDocument
+}
+
+func newDecodedStatistics(document *statisticsDocument) StatisticsDataDefault {
+	decoded := &decodedStatistics{*document}
+	if document.Aggregated == nil {
+		return decoded
+	}
+
+	aggregated := &decodedAggregatedStatistics{decodedStatistics: decoded}
+	for _, run := range document.Aggregated.Runs {
+		aggregated.runs = append(aggregated.runs, newDecodedStatistics(run))
+	}
+	return aggregated
+}
+
+func (stats *decodedStatistics) Generations() int {
+	return stats.document.Generations
+}
+func (stats *decodedStatistics) Duration() time.Duration {
+	return stats.document.Duration
+}
+func (stats *decodedStatistics) Durations() *HierarchicalDuration {
+	return stats.document.Durations
+}
+func (stats *decodedStatistics) MinCost() float64 {
+	return float64(stats.document.MinCost)
+}
+func (stats *decodedStatistics) MinCosts() []float64 {
+	return fromJSONFloats(stats.document.MinCosts)
+}
+func (stats *decodedStatistics) GenerationsWithoutImprovements() int {
+	return stats.document.GenerationsWithoutImprovements
+}
+func (stats *decodedStatistics) MinCostsVar() float64 {
+	return float64(stats.document.MinCostsVar)
+}
+func (stats *decodedStatistics) MeanCost() float64 {
+	return float64(stats.document.MeanCost)
+}
+func (stats *decodedStatistics) MeanCosts() []float64 {
+	return fromJSONFloats(stats.document.MeanCosts)
+}
+func (stats *decodedStatistics) WorstCost() float64 {
+	return float64(stats.document.WorstCost)
+}
+func (stats *decodedStatistics) WorstCosts() []float64 {
+	return fromJSONFloats(stats.document.WorstCosts)
+}
+func (stats *decodedStatistics) Evaluations() int {
+	return stats.document.Evaluations
+}
+func (stats *decodedStatistics) SkippedEvaluations() int {
+	return stats.document.SkippedEvaluations
+}
+func (stats *decodedStatistics) CostCacheHits() int {
+	return stats.document.CostCacheHits
+}
+func (stats *decodedStatistics) CostCacheMisses() int {
+	return stats.document.CostCacheMisses
+}
+func (stats *decodedStatistics) FeasibleFraction() float64 {
+	return float64(stats.document.FeasibleFraction)
+}
+func (stats *decodedStatistics) FeasibleFractions() []float64 {
+	return fromJSONFloats(stats.document.FeasibleFractions)
+}
+func (stats *decodedStatistics) GenotypicDiversity() float64 {
+	return float64(stats.document.GenotypicDiversity)
+}
+func (stats *decodedStatistics) GenotypicDiversities() []float64 {
+	return fromJSONFloats(stats.document.GenotypicDiversities)
+}
+func (stats *decodedStatistics) LocusEntropy() []float64 {
+	return fromJSONFloats(stats.document.LocusEntropy)
+}
+func (stats *decodedStatistics) MeanLocusEntropy() float64 {
+	return meanFloat64(stats.LocusEntropy())
+}
+func (stats *decodedStatistics) MeanLocusEntropies() []float64 {
+	return fromJSONFloats(stats.document.MeanLocusEntropies)
+}
+func (stats *decodedStatistics) CostStdDev() float64 {
+	return float64(stats.document.CostStdDev)
+}
+func (stats *decodedStatistics) CostStdDevs() []float64 {
+	return fromJSONFloats(stats.document.CostStdDevs)
+}
+func (stats *decodedStatistics) UniqueGenotypes() int {
+	return stats.document.UniqueGenotypes
+}
+func (stats *decodedStatistics) UniqueGenotypesCounts() []int {
+	return stats.document.UniqueGenotypesCounts
+}
+func (stats *decodedStatistics) EvaluationsCounts() []int {
+	return stats.document.EvaluationsCounts
+}
+func (stats *decodedStatistics) EvaluationsToTarget(target float64) (int, bool) {
+	return evaluationsToTarget(stats.MinCosts(), stats.EvaluationsCounts(), target)
+}
+
+// Statistics of an aggregator read from JSON
+type decodedAggregatedStatistics struct {
+	*decodedStatistics
+
+	runs []StatisticsDataDefault
+}
+
+func (stats *decodedAggregatedStatistics) Runs() int {
+	return len(stats.runs)
+}
+func (stats *decodedAggregatedStatistics) RunsAt(generation int) int {
+	return runsAt(stats.runs, generation)
+}
+func (stats *decodedAggregatedStatistics) RunsStatistics() []StatisticsDataDefault {
+	return stats.runs
+}
+func (stats *decodedAggregatedStatistics) MinCostsMedians() []float64 {
+	return fromJSONFloats(stats.document.Aggregated.MinCostsMedians)
+}
+func (stats *decodedAggregatedStatistics) MinCostsQuartiles() (lower, upper []float64) {
+	aggregated := stats.document.Aggregated
+	return fromJSONFloats(aggregated.MinCostsLowerQuartiles), fromJSONFloats(aggregated.MinCostsUpperQuartiles)
+}
+func (stats *decodedAggregatedStatistics) MinCostsEnvelope() (lower, upper []float64) {
+	aggregated := stats.document.Aggregated
+	return fromJSONFloats(aggregated.MinCostsMinimums), fromJSONFloats(aggregated.MinCostsMaximums)
+}
+func (stats *decodedAggregatedStatistics) MinCostsConfidenceIntervals() (lower, upper []float64) {
+	aggregated := stats.document.Aggregated
+	return fromJSONFloats(aggregated.MinCostsLowerCIs), fromJSONFloats(aggregated.MinCostsUpperCIs)
+}
+func (stats *decodedAggregatedStatistics) FinalMinCosts() []float64 {
+	return fromJSONFloats(stats.document.Aggregated.FinalMinCosts)
+}
+func (stats *decodedAggregatedStatistics) FinalMinCostQuantile(q float64) float64 {
+	return quantileFloat64(stats.FinalMinCosts(), q)
+}
+func (stats *decodedAggregatedStatistics) SuccessRate(target float64) float64 {
+	return successRate(stats.FinalMinCosts(), target)
+}
+func (stats *decodedAggregatedStatistics) MeanEvaluationsToTarget(target float64) float64 {
+	return meanEvaluationsToTarget(stats.runs, target)
+}
+
+// Float that keeps NaN and infinities, which JSON numbers can't represent, as strings
+type jsonFloat float64
+
+func (f jsonFloat) MarshalJSON() ([]byte, error) {
+	value := float64(f)
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return json.Marshal(strconv.FormatFloat(value, 'g', -1, 64))
+	}
+	return json.Marshal(value)
+}
+func (f *jsonFloat) UnmarshalJSON(data []byte) error {
+	var value float64
+	if err := json.Unmarshal(data, &value); err == nil {
+		*f = jsonFloat(value)
+		return nil
+	}
+
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	value, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return err
+	}
+
+	*f = jsonFloat(value)
+	return nil
+}
+
+func toJSONFloats(values []float64) []jsonFloat {
+	if values == nil {
+		return nil
+	}
+
+	floats := make([]jsonFloat, len(values))
+	for i, value := range values {
+		floats[i] = jsonFloat(value)
+	}
+	return floats
+}
+func fromJSONFloats(floats []jsonFloat) []float64 {
+	if floats == nil {
+		return nil
+	}
+
+	values := make([]float64, len(floats))
+	for i, value := range floats {
+		values[i] = float64(value)
+	}
+	return values
+}
@@ -0,0 +1,450 @@
+package genetic_algorithm
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Stops when any of criteria is met.
+// All criteria are asked on each generation, so criteria with state see every generation.
+type StopCriterionAnyOf struct {
+	logging
+
+	criteria []StopCriterionInterface
+}
+
+func AnyOf(criteria ...StopCriterionInterface) *StopCriterionAnyOf {
+	criterion := new(StopCriterionAnyOf)
+
+	criterion.criteria = criteria
+
+	return criterion
+}
+func (criterion *StopCriterionAnyOf) Setup(options StatisticsOptionsInterface) {
+	setupStopCriteria(criterion.criteria, options)
+}
+func (criterion *StopCriterionAnyOf) ShouldStop(statistics StatisticsDataInterface) bool {
+	stop := false
+	for _, child := range criterion.criteria {
+		stop = child.ShouldStop(statistics) || stop
+	}
+	return stop
+}
+func (criterion *StopCriterionAnyOf) SetLogger(logger LoggerInterface) {
+	criterion.logging.SetLogger(logger)
+	setStopCriteriaLogger(criterion.criteria, logger)
+}
+func (criterion *StopCriterionAnyOf) ComparesCosts() bool {
+	return stopCriteriaCompareCosts(criterion.criteria)
+}
+func (criterion *StopCriterionAnyOf) Check() error {
+	return checkStopCriteria("AnyOf", criterion.criteria)
+}
+func (criterion *StopCriterionAnyOf) MarshalCheckpoint() ([]byte, error) {
+	return marshalStopCriteria(criterion.criteria)
+}
+func (criterion *StopCriterionAnyOf) UnmarshalCheckpoint(data []byte) error {
+	return unmarshalStopCriteria(criterion.criteria, data)
+}
+
+// Stops when all criteria are met.
+// All criteria are asked on each generation, so criteria with state see every generation.
+type StopCriterionAllOf struct {
+	logging
+
+	criteria []StopCriterionInterface
+}
+
+func AllOf(criteria ...StopCriterionInterface) *StopCriterionAllOf {
+	criterion := new(StopCriterionAllOf)
+
+	criterion.criteria = criteria
+
+	return criterion
+}
+func (criterion *StopCriterionAllOf) Setup(options StatisticsOptionsInterface) {
+	setupStopCriteria(criterion.criteria, options)
+}
+func (criterion *StopCriterionAllOf) ShouldStop(statistics StatisticsDataInterface) bool {
+	stop := true
+	for _, child := range criterion.criteria {
+		stop = child.ShouldStop(statistics) && stop
+	}
+	return stop
+}
+func (criterion *StopCriterionAllOf) SetLogger(logger LoggerInterface) {
+	criterion.logging.SetLogger(logger)
+	setStopCriteriaLogger(criterion.criteria, logger)
+}
+func (criterion *StopCriterionAllOf) ComparesCosts() bool {
+	return stopCriteriaCompareCosts(criterion.criteria)
+}
+func (criterion *StopCriterionAllOf) Check() error {
+	return checkStopCriteria("AllOf", criterion.criteria)
+}
+func (criterion *StopCriterionAllOf) MarshalCheckpoint() ([]byte, error) {
+	return marshalStopCriteria(criterion.criteria)
+}
+func (criterion *StopCriterionAllOf) UnmarshalCheckpoint(data []byte) error {
+	return unmarshalStopCriteria(criterion.criteria, data)
+}
+
+// Stops when none of criteria is met, NoneOf(criterion) negates the criterion.
+// There is no Not combinator, since the name would collide with Not of gocheck
+// in tests which dot-import it, tests of this package included.
+// All criteria are asked on each generation, so criteria with state see every generation.
+type StopCriterionNoneOf struct {
+	StopCriterionAnyOf
+}
+
+func NoneOf(criteria ...StopCriterionInterface) *StopCriterionNoneOf {
+	criterion := new(StopCriterionNoneOf)
+
+	criterion.criteria = criteria
+
+	return criterion
+}
+func (criterion *StopCriterionNoneOf) ShouldStop(statistics StatisticsDataInterface) bool {
+	return !criterion.StopCriterionAnyOf.ShouldStop(statistics)
+}
+func (criterion *StopCriterionNoneOf) Check() error {
+	return checkStopCriteria("NoneOf", criterion.criteria)
+}
+
+func stopCriteriaCompareCosts(criteria []StopCriterionInterface) bool {
+	for _, criterion := range criteria {
+		if comparesCosts(criterion) {
+			return true
+		}
+	}
+	return false
+}
+func setupStopCriteria(criteria []StopCriterionInterface, options StatisticsOptionsInterface) {
+	for _, criterion := range criteria {
+		criterion.Setup(options)
+	}
+}
+func setStopCriteriaLogger(criteria []StopCriterionInterface, logger LoggerInterface) {
+	for _, criterion := range criteria {
+		if loggable, ok := criterion.(LoggableInterface); ok {
+			loggable.SetLogger(logger)
+		}
+	}
+}
+
+// State of each criterion, nil for criteria without state
+func marshalStopCriteria(criteria []StopCriterionInterface) ([]byte, error) {
+	states := make([][]byte, len(criteria))
+	for i, criterion := range criteria {
+		state, err := marshalCheckpoint(criterion)
+		if err != nil {
+			return nil, err
+		}
+		states[i] = state
+	}
+	return encodeCheckpoint(states)
+}
+func unmarshalStopCriteria(criteria []StopCriterionInterface, data []byte) error {
+	var states [][]byte
+	if err := decodeCheckpoint(data, &states); err != nil {
+		return err
+	}
+	if len(states) != len(criteria) {
+		return fmt.Errorf("Checkpoint has state of %d criteria, got %d", len(states), len(criteria))
+	}
+
+	for i, criterion := range criteria {
+		if err := unmarshalCheckpoint(criterion, states[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func checkStopCriteria(component string, criteria []StopCriterionInterface) error {
+	components := make([]interface{}, len(criteria))
+	for i, criterion := range criteria {
+		if criterion == nil {
+			return newConfigError(component, "Criterion can't be nil")
+		}
+		components[i] = criterion
+	}
+	return checkAll(components...)
+}
+
+// Stops when duration of optimization exceeds the budget.
+// Time is counted from Setup, i.e. from the start of optimization.
+// Time spent before the checkpoint counts on resume.
+type WallClockStopCriterion struct {
+	configChecker
+	logging
+
+	budget time.Duration
+	start  time.Time
+	now    func() time.Time
+}
+
+func NewWallClockStopCriterion(budget time.Duration) *WallClockStopCriterion {
+	criterion := new(WallClockStopCriterion)
+
+	if budget <= 0 {
+		criterion.invalid("WallClockStopCriterion", "Budget must be positive value")
+	}
+
+	criterion.budget = budget
+	criterion.now = time.Now
+
+	return criterion
+}
+func (criterion *WallClockStopCriterion) Setup(options StatisticsOptionsInterface) {
+	criterion.start = criterion.now()
+}
+func (criterion *WallClockStopCriterion) ShouldStop(statistics StatisticsDataInterface) bool {
+	if criterion.now().Sub(criterion.start) >= criterion.budget {
+		criterion.infof("Stop by wall clock budget")
+		return true
+	}
+	return false
+}
+
+// Saves time spent so far
+func (criterion *WallClockStopCriterion) MarshalCheckpoint() ([]byte, error) {
+	return encodeCheckpoint(criterion.now().Sub(criterion.start))
+}
+func (criterion *WallClockStopCriterion) UnmarshalCheckpoint(data []byte) error {
+	var elapsed time.Duration
+	if err := decodeCheckpoint(data, &elapsed); err != nil {
+		return err
+	}
+
+	criterion.start = criterion.now().Add(-elapsed)
+	return nil
+}
+
+// Stops when number of cost evaluations reaches the budget.
+// Statistics must count evaluations, StatisticsDefault is set up for that.
+type EvaluationBudgetStopCriterion struct {
+	configChecker
+	logging
+
+	budget int
+}
+
+func NewEvaluationBudgetStopCriterion(budget int) *EvaluationBudgetStopCriterion {
+	criterion := new(EvaluationBudgetStopCriterion)
+
+	if budget <= 0 {
+		criterion.invalid("EvaluationBudgetStopCriterion", "Budget must be positive value")
+	}
+
+	criterion.budget = budget
+
+	return criterion
+}
+func (criterion *EvaluationBudgetStopCriterion) Setup(opts StatisticsOptionsInterface) {
+	if options, ok := opts.(*StatisticsDefaultOptions); ok {
+		options.TrackEvaluations()
+	}
+}
+func (criterion *EvaluationBudgetStopCriterion) ShouldStop(statistics StatisticsDataInterface) bool {
+	stats, ok := statistics.(interface {
+		Evaluations() int
+	})
+	if !ok {
+		panic(newConfigError("EvaluationBudgetStopCriterion", "Statistics don't count evaluations"))
+	}
+
+	if stats.Evaluations() >= criterion.budget {
+		criterion.infof("Stop by evaluation budget")
+		return true
+	}
+	return false
+}
+
+// Measure of population diversity
+type DiversityMeasure int
+
+const (
+	// Mean pairwise distance between genotypes
+	GenotypicDiversity DiversityMeasure = iota
+	// Mean entropy of gene values at each locus
+	MeanLocusEntropy
+	// Standard deviation of costs
+	CostStdDev
+	// Number of different genotypes
+	UniqueGenotypes
+)
+
+// Stops when population diversity falls to the threshold or below
+type DiversityStopCriterion struct {
+	configChecker
+	logging
+
+	measure   DiversityMeasure
+	threshold float64
+}
+
+func NewDiversityStopCriterion(measure DiversityMeasure, threshold float64) *DiversityStopCriterion {
+	criterion := new(DiversityStopCriterion)
+
+	if measure < GenotypicDiversity || measure > UniqueGenotypes {
+		criterion.invalid("DiversityStopCriterion", "Unknown diversity measure %d", measure)
+	}
+
+	criterion.measure = measure
+	criterion.threshold = threshold
+
+	return criterion
+}
+func (criterion *DiversityStopCriterion) Setup(opts StatisticsOptionsInterface) {
+	options, ok := opts.(*StatisticsDefaultOptions)
+	if !ok {
+		return
+	}
+
+	switch criterion.measure {
+	case GenotypicDiversity:
+		options.TrackGenotypicDiversity()
+	case MeanLocusEntropy:
+		options.TrackLocusEntropy()
+	case CostStdDev:
+		options.TrackCostStdDev()
+	case UniqueGenotypes:
+		options.TrackUniqueGenotypes()
+	}
+}
+func (criterion *DiversityStopCriterion) ShouldStop(statistics StatisticsDataInterface) bool {
+	diversity := criterion.diversity(statistics)
+	if math.IsNaN(diversity) {
+		panic(newConfigError("DiversityStopCriterion", "Genes don't implement GenesDistanceInterface"))
+	}
+
+	if criterion.logEnabled(LogLevelDebug) {
+		criterion.debugf("Diversity %v", diversity)
+	}
+	if diversity <= criterion.threshold {
+		criterion.infof("Stop by diversity")
+		return true
+	}
+	return false
+}
+func (criterion *DiversityStopCriterion) diversity(statistics StatisticsDataInterface) float64 {
+	switch criterion.measure {
+	case GenotypicDiversity:
+		if stats, ok := statistics.(interface{ GenotypicDiversity() float64 }); ok {
+			return stats.GenotypicDiversity()
+		}
+	case MeanLocusEntropy:
+		if stats, ok := statistics.(interface{ MeanLocusEntropy() float64 }); ok {
+			return stats.MeanLocusEntropy()
+		}
+	case CostStdDev:
+		if stats, ok := statistics.(interface{ CostStdDev() float64 }); ok {
+			return stats.CostStdDev()
+		}
+	case UniqueGenotypes:
+		if stats, ok := statistics.(interface{ UniqueGenotypes() int }); ok {
+			return float64(stats.UniqueGenotypes())
+		}
+	}
+
+	panic(newConfigError("DiversityStopCriterion", "Statistics don't track diversity"))
+}
+
+// Stops when min cost improved by less than the fraction over the last generations.
+// Improvement is relative to the min cost at the start of the window, absolute if that cost is 0.
+type ImprovementStopCriterion struct {
+	configChecker
+	logging
+
+	window         int
+	minImprovement float64
+}
+
+func NewImprovementStopCriterion(window int, minImprovement float64) *ImprovementStopCriterion {
+	criterion := new(ImprovementStopCriterion)
+
+	if window < 1 {
+		criterion.invalid("ImprovementStopCriterion", "Window must be positive value")
+	}
+	if minImprovement < 0 {
+		criterion.invalid("ImprovementStopCriterion", "Improvement can't be negative")
+	}
+
+	criterion.window = window
+	criterion.minImprovement = minImprovement
+
+	return criterion
+}
+func (criterion *ImprovementStopCriterion) Setup(opts StatisticsOptionsInterface) {
+	if options, ok := opts.(*StatisticsDefaultOptions); ok {
+		options.TrackMinCosts()
+	}
+}
+func (criterion *ImprovementStopCriterion) ComparesCosts() bool {
+	return true
+}
+func (criterion *ImprovementStopCriterion) ShouldStop(statistics StatisticsDataInterface) bool {
+	stats, ok := statistics.(interface {
+		MinCosts() []float64
+	})
+	if !ok {
+		panic(newConfigError("ImprovementStopCriterion", "Statistics don't track min costs"))
+	}
+
+	minCosts := stats.MinCosts()
+	if len(minCosts) <= criterion.window {
+		return false
+	}
+
+	old := minCosts[len(minCosts)-1-criterion.window]
+	improvement := old - minCosts[len(minCosts)-1]
+	if old != 0 {
+		improvement /= math.Abs(old)
+	}
+
+	if criterion.logEnabled(LogLevelDebug) {
+		criterion.debugf("Improvement %v", improvement)
+	}
+	if improvement < criterion.minImprovement {
+		criterion.infof("Stop by improvement")
+		return true
+	}
+	return false
+}
+
+// Stops when a value is received from the channel or the channel is closed
+type SignalStopCriterion struct {
+	logging
+
+	signal   <-chan struct{}
+	signaled bool
+}
+
+func NewSignalStopCriterion(signal <-chan struct{}) *SignalStopCriterion {
+	criterion := new(SignalStopCriterion)
+
+	criterion.signal = signal
+
+	return criterion
+}
+func (criterion *SignalStopCriterion) Setup(options StatisticsOptionsInterface) {
+	criterion.signaled = false
+}
+func (criterion *SignalStopCriterion) ShouldStop(statistics StatisticsDataInterface) bool {
+	if !criterion.signaled {
+		select {
+		case <-criterion.signal:
+			criterion.signaled = true
+			criterion.infof("Stop by signal")
+		default:
+		}
+	}
+	return criterion.signaled
+}
+func (criterion *SignalStopCriterion) MarshalCheckpoint() ([]byte, error) {
+	return encodeCheckpoint(criterion.signaled)
+}
+func (criterion *SignalStopCriterion) UnmarshalCheckpoint(data []byte) error {
+	return decodeCheckpoint(data, &criterion.signaled)
+}
@@ -9,3 +9,14 @@ type StopCriterionInterface interface {
 	// Method executes each time before iteration
 	ShouldStop(StatisticsDataInterface) bool
 }
+
+// Stop criteria which compare costs of different generations, e.g. min cost with a value or with the previous min costs.
+// They can't be used with constraint handlers which costs are comparable only within one generation.
+type CostComparingStopCriterionInterface interface {
+	ComparesCosts() bool
+}
+
+func comparesCosts(criterion StopCriterionInterface) bool {
+	comparing, ok := criterion.(CostComparingStopCriterionInterface)
+	return ok && comparing.ComparesCosts()
+}
@@ -1,10 +1,9 @@
 package genetic_algorithm
 
-import (
-	log "github.com/cihub/seelog"
-)
-
 type StopCriterionDefault struct {
+	configChecker
+	logging
+
 	maxGenerations     int
 	maxGenerationsCrit bool
 
@@ -27,7 +26,8 @@ func NewStopCriterionDefault() *StopCriterionDefault {
 // Stop when specified number of generations is reached
 func (criterion *StopCriterionDefault) Max_Generations(value int) *StopCriterionDefault {
 	if value < 0 {
-		panic("Value can't be negative")
+		criterion.invalid("StopCriterionDefault", "Value can't be negative")
+		return criterion
 	}
 
 	criterion.maxGenerationsCrit = true
@@ -35,7 +35,9 @@ func (criterion *StopCriterionDefault) Max_Generations(value int) *StopCriterion
 	return criterion
 }
 
-// Stop when min cost less than or equals value
+// Stop when min cost less than or equals value.
+// With constraints generations without feasible solutions don't stop optimization,
+// since their costs are derived from violations.
 func (criterion *StopCriterionDefault) Min_Cost(value float64) *StopCriterionDefault {
 	criterion.minCostCrit = true
 	criterion.minCost = value
@@ -56,10 +58,18 @@ func (criterion *StopCriterionDefault) Min_MinCostsVar(value float64) *StopCrite
 	return criterion
 }
 
+// Expects StatisticsDefaultOptions only if tracking of generations without improvements or min costs variance is needed
 func (criterion *StopCriterionDefault) Setup(opts StatisticsOptionsInterface) {
+	if options, ok := opts.(*StatisticsDefaultOptions); ok && criterion.minCostCrit {
+		options.TrackFeasibility()
+	}
+	if !criterion.maxGensWoImprvCrit && !criterion.minMinCostsVarCrit {
+		return
+	}
+
 	options, ok := opts.(*StatisticsDefaultOptions)
 	if !ok {
-		panic("Method expects StatisticsDefault")
+		panic(newConfigError("StopCriterionDefault", "Method expects StatisticsDefault"))
 	}
 
 	if criterion.maxGensWoImprvCrit {
@@ -70,37 +80,53 @@ func (criterion *StopCriterionDefault) Setup(opts StatisticsOptionsInterface) {
 	}
 }
 
+func (criterion *StopCriterionDefault) ComparesCosts() bool {
+	return criterion.minCostCrit || criterion.maxGensWoImprvCrit || criterion.minMinCostsVarCrit
+}
+
 func (criterion *StopCriterionDefault) ShouldStop(statistics StatisticsDataInterface) bool {
 	stats, ok := statistics.(StatisticsDataDefault)
 	if !ok {
-		panic("Method expects StatisticsDefault")
+		panic(newConfigError("StopCriterionDefault", "Method expects StatisticsDefault"))
 	}
 
 	if criterion.maxGenerationsCrit && stats.Generations() >= criterion.maxGenerations {
-		log.Info("Stop by max generations")
+		criterion.infof("Stop by max generations")
 		return true
 	}
 	if criterion.minCostCrit {
-		log.Debugf("MinCost %v", stats.MinCost())
-		if stats.MinCost() <= criterion.minCost {
-			log.Info("Stop by min cost")
+		if criterion.logEnabled(LogLevelDebug) {
+			criterion.debugf("MinCost %v", stats.MinCost())
+		}
+		if stats.MinCost() <= criterion.minCost && !criterion.infeasible(stats) {
+			criterion.infof("Stop by min cost")
 			return true
 		}
 	}
 	if criterion.maxGensWoImprvCrit {
-		log.Debugf("GenerationsWithoutImprovements %v", stats.GenerationsWithoutImprovements())
+		if criterion.logEnabled(LogLevelDebug) {
+			criterion.debugf("GenerationsWithoutImprovements %v", stats.GenerationsWithoutImprovements())
+		}
 		if stats.GenerationsWithoutImprovements() >= criterion.maxGensWoImprv {
-			log.Info("Stop by max min cost's age")
+			criterion.infof("Stop by max min cost's age")
 			return true
 		}
 	}
 	if criterion.minMinCostsVarCrit {
-		log.Debugf("MinCostsVar %v", stats.MinCostsVar())
+		if criterion.logEnabled(LogLevelDebug) {
+			criterion.debugf("MinCostsVar %v", stats.MinCostsVar())
+		}
 		if stats.MinCostsVar() >= criterion.minMinCostsVar {
-			log.Info("Stop by max min costs var")
+			criterion.infof("Stop by max min costs var")
 			return true
 		}
 	}
 
 	return false
 }
+
+// Whether population of the last generation has no feasible solutions
+func (criterion *StopCriterionDefault) infeasible(stats StatisticsDataDefault) bool {
+	fractions := stats.FeasibleFractions()
+	return len(fractions) > 0 && fractions[len(fractions)-1] == 0
+}
@@ -0,0 +1,104 @@
+package genetic_algorithm
+
+// Defines which islands receive migrants from an island
+type TopologyInterface interface {
+	// Returns indexes of islands that receive migrants from the island
+	Neighbours(island, islands int) []int
+}
+
+// Each island sends migrants to the next one, the last island sends to the first.
+type RingTopology struct{}
+
+func NewRingTopology() *RingTopology {
+	return new(RingTopology)
+}
+func (topology *RingTopology) Neighbours(island, islands int) []int {
+	if islands < 2 {
+		return nil
+	}
+	return []int{(island + 1) % islands}
+}
+
+// Each island sends migrants to all other islands.
+type FullyConnectedTopology struct{}
+
+func NewFullyConnectedTopology() *FullyConnectedTopology {
+	return new(FullyConnectedTopology)
+}
+func (topology *FullyConnectedTopology) Neighbours(island, islands int) []int {
+	neighbours := make([]int, 0, islands)
+	for i := 0; i < islands; i++ {
+		if i != island {
+			neighbours = append(neighbours, i)
+		}
+	}
+	return neighbours
+}
+
+// Each island sends migrants to randomly chosen islands, new ones on each migration.
+type RandomTopology struct {
+	randomizer
+	configChecker
+
+	neighbours int
+}
+
+func NewRandomTopology(neighbours int) *RandomTopology {
+	topology := new(RandomTopology)
+
+	if neighbours < 1 {
+		topology.invalid("RandomTopology", "Neighbours count must be positive")
+	}
+
+	topology.neighbours = neighbours
+
+	return topology
+}
+func (topology *RandomTopology) Neighbours(island, islands int) []int {
+	count := topology.neighbours
+	if count > islands-1 {
+		count = islands - 1
+	}
+	if count <= 0 {
+		return nil
+	}
+
+	// Choose among other islands, indexes after the island are shifted by one
+	neighbours := topology.chooseDifferentRandomNumbers(count, islands-1)
+	for i, neighbour := range neighbours {
+		if neighbour >= island {
+			neighbours[i] = neighbour + 1
+		}
+	}
+	return neighbours
+}
+
+// Topology with explicitly specified neighbours of each island
+type CustomTopology struct {
+	configChecker
+
+	adjacency [][]int
+}
+
+// adjacency[i] lists islands that receive migrants from the island i
+func NewCustomTopology(adjacency [][]int) *CustomTopology {
+	topology := new(CustomTopology)
+
+	for i, neighbours := range adjacency {
+		for _, neighbour := range neighbours {
+			if neighbour < 0 || neighbour >= len(adjacency) || neighbour == i {
+				topology.invalid("CustomTopology", "Incorrect neighbour %d of island %d", neighbour, i)
+			}
+		}
+	}
+
+	topology.adjacency = adjacency
+
+	return topology
+}
+func (topology *CustomTopology) Neighbours(island, islands int) []int {
+	if len(topology.adjacency) != islands {
+		panic(newConfigError("CustomTopology", "Adjacency is set for %d islands, got %d", len(topology.adjacency), islands))
+	}
+	return topology.adjacency[island]
+}
@@ -1,7 +1,6 @@
 package genetic_algorithm
 
 import (
-	log "github.com/cihub/seelog"
 	"math"
 )
 
@@ -12,18 +11,21 @@ type WeederInterface interface {
 
 // SimpleWeeder weeds part of population proportional to rate.
 type SimpleWeeder struct {
+	configChecker
+	logging
+
 	rate float64
 }
 
 // Creates new SimpleWeeder.
 // Rate must be in [0,100)
 func NewSimpleWeeder(rate float64) *SimpleWeeder {
+	weeder := new(SimpleWeeder)
+
 	if rate < 0 || rate >= 100 {
-		panic("Rate must be in [0,100)")
+		weeder.invalid("SimpleWeeder", "Rate must be in [0,100)")
 	}
 
-	weeder := new(SimpleWeeder)
-
 	weeder.rate = rate
 
 	return weeder
@@ -31,7 +33,9 @@ func NewSimpleWeeder(rate float64) *SimpleWeeder {
 func (weeder *SimpleWeeder) Weed(pop []ChromosomeInterface) []ChromosomeInterface {
 	popLen := len(pop)
 
-	log.Tracef("Weed Rate=%f Population=%d\n", weeder.rate, popLen)
+	if weeder.logEnabled(LogLevelTrace) {
+		weeder.tracef("Weed Rate=%f Population=%d", weeder.rate, popLen)
+	}
 
 	toWeed := int(math.Floor(float64(popLen) / 100.0 * weeder.rate))
 	return pop[:popLen-toWeed]
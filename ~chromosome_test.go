@@ -0,0 +1,172 @@
+package genetic_algorithm
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type ChromosomeSuite struct{}
+
+var _ = Suite(&ChromosomeSuite{})
+
+func (s *ChromosomeSuite) TestRealBounds_Clamp(c *C) {
+	bounds := NewRealBounds([]float64{0, -1}, []float64{1, 1})
+
+	c.Assert(bounds.Clamp(0, -0.5), Equals, 0.0)
+	c.Assert(bounds.Clamp(0, 0.5), Equals, 0.5)
+	c.Assert(bounds.Clamp(1, -2), Equals, -1.0)
+	c.Assert(bounds.Clamp(1, 2), Equals, 1.0)
+}
+func (s *ChromosomeSuite) TestRealBounds_Uniform(c *C) {
+	bounds := NewUniformRealBounds(-5, 5)
+
+	c.Assert(bounds.Fits(1), Equals, true)
+	c.Assert(bounds.Fits(100), Equals, true)
+	c.Assert(bounds.Lower(42), Equals, -5.0)
+	c.Assert(bounds.Upper(42), Equals, 5.0)
+}
+func (s *ChromosomeSuite) TestRealChromosome_Should_Panic_WhenBoundsDoNotFit(c *C) {
+	bounds := NewRealBounds([]float64{0, 0}, []float64{1, 1})
+
+	c.Assert(func() { NewRealChromosome(make(RealGenes, 3), bounds) }, PanicMatches, `RealChromosome: Bounds.*`)
+	c.Assert(func() { NewRealChromosome(make(RealGenes, 3), nil) }, PanicMatches, `RealChromosome: Bounds must be set`)
+	c.Assert(func() { NewIntegerChromosome(make(IntegerGenes, 3), NewIntegerBounds([]int{0, 0}, []int{1, 1})) },
+		PanicMatches, `IntegerChromosome: Bounds.*`)
+}
+func (s *ChromosomeSuite) TestRealRandomInitializer_RespectsBounds(c *C) {
+	bounds := NewRealBounds([]float64{0, 10, -3}, []float64{1, 20, -3})
+
+	pop := NewRealRandomInitializer(bounds).Init(20, 3)
+
+	c.Assert(len(pop), Equals, 20)
+	for _, chrom := range pop {
+		genes := chrom.(*RealChromosome).RealGenes()
+		for i, g := range genes {
+			if g < bounds.Lower(i) || g > bounds.Upper(i) {
+				c.Fatalf("Gene %d out of bounds: %v", i, genes)
+			}
+		}
+	}
+}
+
+func (s *ChromosomeSuite) TestIntegerAlphabetBounds(c *C) {
+	bounds := NewIntegerAlphabetBounds([]int{1, 3})
+
+	c.Assert(bounds.Lower(1), Equals, 0)
+	c.Assert(bounds.Upper(1), Equals, 2)
+	c.Assert(bounds.Size(0), Equals, 1)
+	c.Assert(bounds.Clamp(1, 5), Equals, 2)
+}
+func (s *ChromosomeSuite) TestIntegerRandomInitializer_RespectsBounds(c *C) {
+	bounds := NewIntegerBounds([]int{0, 10, -3}, []int{1, 20, -3})
+
+	pop := NewIntegerRandomInitializer(bounds).Init(20, 3)
+
+	c.Assert(len(pop), Equals, 20)
+	for _, chrom := range pop {
+		assertIntegerGenesWithinBounds(c, chrom, bounds)
+	}
+}
+func (s *ChromosomeSuite) TestIntegerChromosome_MultiPointCrossover(c *C) {
+	bounds := NewUniformIntegerBounds(0, 9)
+
+	parent1 := NewIntegerChromosome(IntegerGenes{1, 2, 3, 4}, bounds)
+	parent2 := NewIntegerChromosome(IntegerGenes{5, 6, 7, 8}, bounds)
+
+	c1, c2 := NewMultiPointCrossover(NewEmptyIntegerChromosomeConstructor(bounds), 3).
+		crossover(parent1, parent2, []int{1, 2, 3})
+
+	c.Assert(c1.Genes(), DeepEquals, IntegerGenes{1, 6, 3, 8})
+	c.Assert(c2.Genes(), DeepEquals, IntegerGenes{5, 2, 7, 4})
+	c.Assert(c1.(*IntegerChromosome).Bounds(), Equals, bounds)
+}
+
+func (s *ChromosomeSuite) TestChromosome_Copy(c *C) {
+	chrom := NewBinaryChromosome(BinaryGenes{true, false})
+	chrom.SetCost(1)
+
+	copied := copyOf(chrom).(*BinaryChromosome)
+	copied.BinaryGenes()[0] = false
+	MarkDirty(copied)
+
+	c.Assert(chrom.BinaryGenes(), DeepEquals, BinaryGenes{true, false})
+	c.Assert(chrom.Dirty(), Equals, false)
+	c.Assert(copied.Cost(), Equals, 1.0)
+
+	bounds := NewUniformRealBounds(0, 1)
+	real := NewRealChromosome(RealGenes{0.5}, bounds)
+	c.Assert(real.Copy().(*RealChromosome).Bounds(), Equals, bounds)
+	c.Assert(real.Copy().(*RealChromosome).Dirty(), Equals, true)
+}
+func assertIntegerGenesWithinBounds(c *C, chrom ChromosomeInterface, bounds *IntegerBounds) {
+	genes := chrom.(*IntegerChromosome).IntegerGenes()
+	for i, g := range genes {
+		if g < bounds.Lower(i) || g > bounds.Upper(i) {
+			c.Fatalf("Gene %d out of bounds: %v", i, genes)
+		}
+	}
+}
+
+func (s *ChromosomeSuite) TestChromosomes_SetCostParallel(c *C) {
+	cost := func(chrom ChromosomeInterface) float64 {
+		sum := 0
+		for _, g := range chrom.(*IntegerChromosome).IntegerGenes() {
+			sum += g
+		}
+		return float64(sum)
+	}
+
+	pop := NewIntegerRandomInitializer(NewUniformIntegerBounds(0, 100)).Init(50, 10)
+	pop.SetCost(cost)
+
+	expected := make([]float64, len(pop))
+	for i, chrom := range pop {
+		expected[i] = chrom.Cost()
+		chrom.SetCost(-1)
+	}
+
+	pop.SetCostParallel(cost, 8)
+
+	for i, chrom := range pop {
+		c.Assert(chrom.Cost(), Equals, expected[i])
+	}
+}
+
+func (s *ChromosomeSuite) TestChromosomes_Dirty(c *C) {
+	clean := NewBinaryChromosome(BinaryGenes{true})
+	clean.SetCost(1)
+	dirty := NewBinaryChromosome(BinaryGenes{false})
+
+	pop := Chromosomes{clean, dirty, dirty}
+	c.Assert(pop.Dirty(), DeepEquals, Chromosomes{dirty})
+
+	clean.MarkDirty()
+	c.Assert(pop.Dirty(), DeepEquals, Chromosomes{clean, dirty})
+}
+func (s *ChromosomeSuite) TestBinaryGenes_Distance(c *C) {
+	genes := BinaryGenes{true, false, true, true}
+
+	c.Assert(genes.Distance(BinaryGenes{true, false, true, true}), Equals, 0.0)
+	c.Assert(genes.Distance(BinaryGenes{false, false, true, false}), Equals, 2.0)
+}
+func (s *ChromosomeSuite) TestOrderedGenes_Distance(c *C) {
+	genes := OrderedGenes{0, 1, 2, 3}
+
+	c.Assert(genes.Distance(OrderedGenes{0, 1, 2, 3}), Equals, 0.0)
+	c.Assert(genes.Distance(OrderedGenes{1, 0, 2, 3}), Equals, 1.0)
+	c.Assert(genes.Distance(OrderedGenes{3, 2, 1, 0}), Equals, 6.0)
+	c.Assert(OrderedGenes{3, 2, 1, 0}.Distance(genes), Equals, 6.0)
+}
+func (s *ChromosomeSuite) TestRealGenes_Distance(c *C) {
+	genes := RealGenes{0, 1.5, -2}
+
+	c.Assert(genes.Distance(RealGenes{0, 1.5, -2}), Equals, 0.0)
+	c.Assert(genes.Distance(RealGenes{3, 5.5, -2}), Equals, 5.0)
+	c.Assert(RealGenes{3, 5.5, -2}.Distance(genes), Equals, 5.0)
+}
+func (s *ChromosomeSuite) TestIntegerGenes_Distance(c *C) {
+	genes := IntegerGenes{0, 5, -2}
+
+	c.Assert(genes.Distance(IntegerGenes{0, 5, -2}), Equals, 0.0)
+	c.Assert(genes.Distance(IntegerGenes{3, 1, -2}), Equals, 7.0)
+	c.Assert(IntegerGenes{3, 1, -2}.Distance(genes), Equals, 7.0)
+}
@@ -0,0 +1,95 @@
+package genetic_algorithm
+
+import (
+	. "gopkg.in/check.v1"
+	"math/rand"
+)
+
+type ConstraintSuite struct{}
+
+var _ = Suite(&ConstraintSuite{})
+
+var constrainedCosts = []ConstrainedCost{
+	{Objective: 5, Violation: 0},
+	{Objective: 1, Violation: 2},
+	{Objective: 3, Violation: 0},
+	{Objective: 0, Violation: 0.5},
+}
+
+func (s *ConstraintSuite) TestConstrainedCost_Better(c *C) {
+	c.Assert(constrainedCosts[2].Better(constrainedCosts[0]), Equals, true)
+	c.Assert(constrainedCosts[0].Better(constrainedCosts[2]), Equals, false)
+	c.Assert(constrainedCosts[0].Better(constrainedCosts[3]), Equals, true)
+	c.Assert(constrainedCosts[3].Better(constrainedCosts[1]), Equals, true)
+	c.Assert(constrainedCosts[1].Better(constrainedCosts[3]), Equals, false)
+	c.Assert(constrainedCosts[2].Better(constrainedCosts[2]), Equals, false)
+}
+func (s *ConstraintSuite) TestStaticPenalty(c *C) {
+	population := chromosomesWithCosts(0, 0, 0, 0)
+
+	NewStaticPenalty(10).Rank(population, constrainedCosts)
+
+	c.Assert(costsOf(population), DeepEquals, []float64{5, 21, 3, 5})
+	c.Assert(NewStaticPenalty(-1).Check(), NotNil)
+}
+func (s *ConstraintSuite) TestAdaptivePenalty(c *C) {
+	handler := NewAdaptivePenalty(1, 2)
+	feasibleBest := []ConstrainedCost{{Objective: 1}, {Objective: 2, Violation: 1}}
+	infeasibleBest := []ConstrainedCost{{Objective: 3}, {Objective: 0, Violation: 1}}
+
+	generation := 0
+	rank := func(costs []ConstrainedCost) {
+		handler.Rank(chromosomesWithCosts(0, 0), costs)
+		handler.SetGeneration(generation)
+		generation++
+	}
+
+	rank(feasibleBest)
+	c.Assert(handler.Coefficient(), Equals, 1.0)
+	rank(feasibleBest)
+	c.Assert(handler.Coefficient(), Equals, 0.5)
+	// With coefficient 0.5 cost of the infeasible solution is 0.5, it's the best
+	rank(infeasibleBest)
+	c.Assert(handler.Coefficient(), Equals, 0.5)
+	rank(infeasibleBest)
+	c.Assert(handler.Coefficient(), Equals, 1.5)
+
+	generation = 0
+	rank(infeasibleBest)
+	c.Assert(handler.Coefficient(), Equals, 1.0)
+
+	c.Assert(NewAdaptivePenalty(1, 2).Factors(1, 2).Check(), NotNil)
+}
+func (s *ConstraintSuite) TestFeasibilityRules(c *C) {
+	population := chromosomesWithCosts(0, 0, 0, 0)
+
+	NewFeasibilityRules().Rank(population, constrainedCosts)
+
+	c.Assert(costsOf(population), DeepEquals, []float64{5, 7, 3, 5.5})
+
+	population = chromosomesWithCosts(0, 0)
+	NewFeasibilityRules().Rank(population, []ConstrainedCost{constrainedCosts[3], constrainedCosts[1]})
+	c.Assert(costsOf(population), DeepEquals, []float64{0.5, 2})
+}
+func (s *ConstraintSuite) TestStochasticRanking(c *C) {
+	population := chromosomesWithCosts(0, 0, 0, 0)
+
+	// Without comparison by objective infeasible solutions are ordered by violation after feasible ones
+	NewStochasticRanking(0).Rank(population, constrainedCosts)
+	c.Assert(costsOf(population), DeepEquals, []float64{1, 3, 0, 2})
+
+	NewStochasticRanking(1).Rank(population, constrainedCosts)
+	c.Assert(costsOf(population), DeepEquals, []float64{3, 1, 2, 0})
+
+	handler := NewStochasticRanking(0.45)
+	handler.SetRand(rand.New(NewRandomSource(1)))
+	handler.Rank(population, constrainedCosts)
+
+	positions := make(map[float64]bool)
+	for _, cost := range costsOf(population) {
+		positions[cost] = true
+	}
+	c.Assert(positions, HasLen, 4)
+
+	c.Assert(NewStochasticRanking(1.5).Check(), NotNil)
+}
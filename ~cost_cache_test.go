@@ -0,0 +1,108 @@
+package genetic_algorithm
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type CostCacheSuite struct{}
+
+var _ = Suite(&CostCacheSuite{})
+
+func (s *CostCacheSuite) TestCostCache_HitsAndMisses(c *C) {
+	evaluations := 0
+	cache := NewCostCache(func(chrom ChromosomeInterface) float64 {
+		evaluations++
+		return countOnes(chrom)
+	}, 10)
+
+	c.Assert(cache.Cost(NewBinaryChromosome(BinaryGenes{true, true})), Equals, 2.0)
+	c.Assert(cache.Cost(NewBinaryChromosome(BinaryGenes{true, true})), Equals, 2.0)
+	c.Assert(cache.Cost(NewBinaryChromosome(BinaryGenes{true, false})), Equals, 1.0)
+
+	c.Assert(evaluations, Equals, 2)
+	c.Assert(cache.Hits(), Equals, 1)
+	c.Assert(cache.Misses(), Equals, 2)
+}
+func (s *CostCacheSuite) TestCostCache_EvictsLeastRecentlyUsed(c *C) {
+	evaluations := 0
+	cache := NewCostCache(func(chrom ChromosomeInterface) float64 {
+		evaluations++
+		return 0
+	}, 2)
+
+	g1 := NewOrderedChromosome(OrderedGenes{0, 1})
+	g2 := NewOrderedChromosome(OrderedGenes{1, 0})
+	g3 := NewOrderedChromosome(OrderedGenes{1, 1})
+
+	cache.Cost(g1)
+	cache.Cost(g2)
+	cache.Cost(g1)
+	cache.Cost(g3)
+	c.Assert(cache.Len(), Equals, 2)
+	c.Assert(evaluations, Equals, 3)
+
+	cache.Cost(g1)
+	c.Assert(evaluations, Equals, 3)
+	cache.Cost(g2)
+	c.Assert(evaluations, Equals, 4)
+}
+func (s *CostCacheSuite) TestCostCache_HashCollisions(c *C) {
+	evaluations := 0
+	cache := NewCostCache(func(chrom ChromosomeInterface) float64 {
+		evaluations++
+		return countOnes(chrom)
+	}, 10).HashFunction(func(genes GenesInterface) uint64 {
+		return 0
+	})
+
+	c.Assert(cache.Cost(NewBinaryChromosome(BinaryGenes{true, true})), Equals, 2.0)
+	c.Assert(cache.Cost(NewBinaryChromosome(BinaryGenes{true, false})), Equals, 1.0)
+	c.Assert(cache.Cost(NewBinaryChromosome(BinaryGenes{true, false})), Equals, 1.0)
+	c.Assert(cache.Cost(NewBinaryChromosome(BinaryGenes{true, true})), Equals, 2.0)
+
+	c.Assert(evaluations, Equals, 3)
+	c.Assert(cache.Hits(), Equals, 1)
+}
+func (s *CostCacheSuite) TestCostCache_HashCollisions_GenericGenes(c *C) {
+	cache := NewCostCache(func(chrom ChromosomeInterface) float64 {
+		return float64(chrom.Genes().Get(0).(int))
+	}, 10).HashFunction(func(genes GenesInterface) uint64 {
+		return 0
+	})
+
+	c.Assert(cache.Cost(newCustomChromosome(1, 2)), Equals, 1.0)
+	c.Assert(cache.Cost(newCustomChromosome(2, 1)), Equals, 2.0)
+	c.Assert(cache.Cost(newCustomChromosome(2, 1)), Equals, 2.0)
+	c.Assert(cache.Hits(), Equals, 1)
+}
+func (s *CostCacheSuite) TestCostCache_PanicsWithConfigErrors(c *C) {
+	c.Assert(func() { NewCostCache(nil, 10) }, PanicMatches, "CostCache: Cost must be set")
+	c.Assert(func() { NewCostCache(countOnes, 0) }, PanicMatches, "CostCache: Capacity .*")
+	c.Assert(func() { NewCostCache(countOnes, 10).HashFunction(nil) }, PanicMatches, "CostCache: Hash must be set")
+}
+func (s *CostCacheSuite) TestHashGenes_GenericGenes(c *C) {
+	c.Assert(HashGenes(customGenes{1, 2}), Equals, HashGenes(customGenes{1, 2}))
+	c.Assert(HashGenes(customGenes{1, 2}) != HashGenes(customGenes{2, 1}), Equals, true)
+}
+
+type customGenes []int
+
+func (g customGenes) Len() int                   { return len(g) }
+func (g customGenes) Swap(i, j int)              { g[i], g[j] = g[j], g[i] }
+func (g customGenes) Get(i int) interface{}      { return g[i] }
+func (g customGenes) Set(i int, val interface{}) { g[i] = val.(int) }
+func (g customGenes) Copy(genes GenesInterface, from1, from2, to2 int) int {
+	return copy(g[from1:], genes.(customGenes)[from2:to2])
+}
+
+type customChromosome struct {
+	*ChromosomeBase
+	genes customGenes
+}
+
+func newCustomChromosome(genes ...int) *customChromosome {
+	return &customChromosome{NewChromosomeBase(), customGenes(genes)}
+}
+func (chrom *customChromosome) Genes() GenesInterface {
+	return chrom.genes
+}
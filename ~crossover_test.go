@@ -232,6 +232,115 @@ func (s *CrossoverSuite) TestEdgeRecombinationCrossover_crossover(c *C) {
 	}
 }
 
+func (s *CrossoverSuite) TestMaskCrossover_crossover(c *C) {
+	parent1 := NewBinaryChromosome(BinaryGenes{true, true, true, true})
+	parent2 := NewBinaryChromosome(BinaryGenes{false, false, false, false})
+
+	crossover := NewMaskCrossover(NewEmptyBinaryChromosome, func(genesLen int) []bool {
+		return make([]bool, genesLen)
+	})
+	c1, c2 := crossover.crossover(parent1, parent2, []bool{false, true, true, false})
+
+	c.Assert(c1.Genes(), DeepEquals, BinaryGenes{true, false, false, true})
+	c.Assert(c2.Genes(), DeepEquals, BinaryGenes{false, true, true, false})
+}
+func (s *CrossoverSuite) TestMaskCrossover_CantProduceCopiesOfParents(c *C) {
+	parent1 := NewIntegerChromosome(IntegerGenes{1, 2, 3}, NewUniformIntegerBounds(0, 9))
+	parent2 := NewIntegerChromosome(IntegerGenes{4, 5, 6}, NewUniformIntegerBounds(0, 9))
+
+	masks := []CrossoverMaskFunction{
+		func(genesLen int) []bool { return make([]bool, genesLen) },
+		func(genesLen int) []bool { return []bool{true, true, true} },
+	}
+
+	for _, mask := range masks {
+		crossover := NewMaskCrossover(NewEmptyIntegerChromosomeConstructor(NewUniformIntegerBounds(0, 9)), mask)
+		children := crossover.Crossover(Chromosomes{parent1, parent2})
+
+		if reflect.DeepEqual(children[0].Genes(), parent1.Genes()) || reflect.DeepEqual(children[0].Genes(), parent2.Genes()) {
+			c.Fatalf("Child is a copy of parent: %v", children[0].Genes())
+		}
+	}
+}
+func (s *CrossoverSuite) TestUniformCrossover_SwapProbability(c *C) {
+	parent1 := NewBinaryChromosome(BinaryGenes{true, true, true})
+	parent2 := NewBinaryChromosome(BinaryGenes{false, false, false})
+
+	var crossover *UniformCrossover = NewUniformCrossover(NewEmptyBinaryChromosome, 1).
+		CanProduceCopiesOfParents(true)
+	children := crossover.Crossover(Chromosomes{parent1, parent2})
+
+	c.Assert(children[0].Genes(), DeepEquals, BinaryGenes{false, false, false})
+	c.Assert(children[1].Genes(), DeepEquals, BinaryGenes{true, true, true})
+}
+
+func (s *CrossoverSuite) TestArithmeticCrossover_whole(c *C) {
+	bounds := NewUniformRealBounds(0, 4)
+
+	parent1 := NewRealChromosome(RealGenes{0, 4}, bounds)
+	parent2 := NewRealChromosome(RealGenes{4, 0}, bounds)
+
+	c1, c2 := NewWholeArithmeticCrossover().Alpha(0.25).crossover(parent1, parent2, 0.25, 0)
+
+	c.Assert(c1.RealGenes(), DeepEquals, RealGenes{3, 1})
+	c.Assert(c2.RealGenes(), DeepEquals, RealGenes{1, 3})
+}
+func (s *CrossoverSuite) TestArithmeticCrossover_simple(c *C) {
+	bounds := NewUniformRealBounds(0, 4)
+
+	parent1 := NewRealChromosome(RealGenes{0, 0, 4}, bounds)
+	parent2 := NewRealChromosome(RealGenes{4, 4, 0}, bounds)
+
+	c1, c2 := NewSimpleArithmeticCrossover().crossover(parent1, parent2, 0.5, 1)
+
+	c.Assert(c1.RealGenes(), DeepEquals, RealGenes{0, 2, 2})
+	c.Assert(c2.RealGenes(), DeepEquals, RealGenes{4, 2, 2})
+}
+func (s *CrossoverSuite) TestSimulatedBinaryCrossover_RespectsBounds(c *C) {
+	bounds := NewRealBounds([]float64{0, -1, 10}, []float64{1, 1, 20})
+
+	parent1 := NewRealChromosome(RealGenes{0, 1, 10}, bounds)
+	parent2 := NewRealChromosome(RealGenes{0.1, -1, 20}, bounds)
+
+	crossover := NewSimulatedBinaryCrossover(2).GeneProbability(1)
+	for j := 0; j < 100; j++ {
+		children := crossover.Crossover(Chromosomes{parent1, parent2})
+		assertRealGenesWithinBounds(c, children[0], bounds)
+		assertRealGenesWithinBounds(c, children[1], bounds)
+	}
+}
+func (s *CrossoverSuite) TestSimulatedBinaryCrossover_CantProduceCopiesOfParents(c *C) {
+	bounds := NewUniformRealBounds(0, 1)
+
+	parent1 := NewRealChromosome(RealGenes{0.2, 0.3}, bounds)
+	parent2 := NewRealChromosome(RealGenes{0.2, 0.7}, bounds)
+
+	crossover := NewSimulatedBinaryCrossover(2).GeneProbability(0)
+	for j := 0; j < 10; j++ {
+		children := crossover.Crossover(Chromosomes{parent1, parent2})
+		genes := children[0].(*RealChromosome).RealGenes()
+
+		c.Assert(genes[0], Equals, 0.2)
+		if genes[1] == 0.3 || genes[1] == 0.7 {
+			c.Fatalf("Child is a copy of parent: %v", genes)
+		}
+	}
+}
+func (s *CrossoverSuite) TestBlendCrossover_RespectsBounds(c *C) {
+	bounds := NewUniformRealBounds(-1, 1)
+
+	parent1 := NewRealChromosome(RealGenes{-1, 0.5, 0}, bounds)
+	parent2 := NewRealChromosome(RealGenes{1, 0.9, 0}, bounds)
+
+	crossover := NewBlendCrossover(0.5)
+	for j := 0; j < 100; j++ {
+		children := crossover.Crossover(Chromosomes{parent1, parent2})
+		assertRealGenesWithinBounds(c, children[0], bounds)
+		assertRealGenesWithinBounds(c, children[1], bounds)
+		c.Assert(children[0].(*RealChromosome).RealGenes()[2], Equals, 0.0)
+	}
+}
+
 func compareTwoBinaryGenesWithoutOrder(c *C, c1, c2 ChromosomeInterface, ec1, ec2 BinaryGenes) {
 	var expC2 BinaryGenes
 	if reflect.DeepEqual(c1.Genes(), ec1) {
@@ -260,3 +369,11 @@ func compareTwoOrderedGenesWithoutOrder(c *C, c1, c2 ChromosomeInterface, ec1, e
 		c.Fatalf("Unexpected child2 genes. Exp: [%v]. Got: [%v]", expC2, c2.Genes())
 	}
 }
+func assertRealGenesWithinBounds(c *C, chrom ChromosomeInterface, bounds *RealBounds) {
+	genes := chrom.(*RealChromosome).RealGenes()
+	for i, g := range genes {
+		if g < bounds.Lower(i) || g > bounds.Upper(i) {
+			c.Fatalf("Gene %d out of bounds: %v", i, genes)
+		}
+	}
+}
@@ -16,6 +16,40 @@ func (s *HelperSuite) Test_MeanInt64(c *C) {
 
 	c.Assert(meanInt64(values), Equals, result)
 }
+func (s *HelperSuite) Test_MeanInt64_Rounds(c *C) {
+	c.Assert(meanInt64([]int64{1, 2}), Equals, int64(2))
+	c.Assert(meanInt64([]int64{1, 1, 2}), Equals, int64(1))
+}
+func (s *HelperSuite) Test_QuantileFloat64(c *C) {
+	values := []float64{1, 2, 3, 4}
+
+	c.Assert(quantileFloat64(values, 0), Equals, 1.0)
+	c.Assert(quantileFloat64(values, 0.5), Equals, 2.5)
+	c.Assert(quantileFloat64(values, 0.25), Equals, 1.75)
+	c.Assert(quantileFloat64(values, 1), Equals, 4.0)
+	c.Assert(math.IsNaN(quantileFloat64(nil, 0.5)), Equals, true)
+}
+func (s *HelperSuite) Test_ConfidenceInterval95(c *C) {
+	lower, upper := confidenceInterval95([]float64{1, 2, 3})
+	c.Assert(lower, Within, 1e-9, 2-4.303/math.Sqrt(3))
+	c.Assert(upper, Within, 1e-9, 2+4.303/math.Sqrt(3))
+
+	lower, upper = confidenceInterval95([]float64{5})
+	c.Assert(lower, Equals, 5.0)
+	c.Assert(upper, Equals, 5.0)
+}
+func (s *HelperSuite) Test_TQuantile95(c *C) {
+	c.Assert(tQuantile95(1), Equals, 12.706)
+	c.Assert(tQuantile95(30), Equals, 2.042)
+	c.Assert(tQuantile95(31), Within, 1e-3, 2.040)
+	c.Assert(tQuantile95(40), Within, 1e-9, 2.021)
+	c.Assert(tQuantile95(50), Within, 1e-3, 2.009)
+	c.Assert(tQuantile95(1000), Within, 1e-3, 1.962)
+
+	for df := 1; df < 2000; df++ {
+		c.Assert(tQuantile95(df+1) < tQuantile95(df), Equals, true)
+	}
+}
 func (s *HelperSuite) Test_MeanFloat64Arr(c *C) {
 	values := [][]float64{
 		[]float64{2},
@@ -40,20 +74,20 @@ func (s *HelperSuite) Test_MeanFloat64Arr_BigNumbers(c *C) {
 }
 
 func (s *HelperSuite) Test_chooseTwoPointCrossSection_firstCrossPoint(c *C) {
-	p, _ := chooseTwoPointCrossSection(1, true)
+	p, _ := new(randomizer).chooseTwoPointCrossSection(1, true)
 	if p != 0 {
 		c.Fatalf("Choose inappropriate point: %v", p)
 	}
 }
 func (s *HelperSuite) Test_chooseTwoPointCrossSection_secondCrossPoint_notEqualsFirst(c *C) {
-	_, p := chooseTwoPointCrossSection(1, true)
+	_, p := new(randomizer).chooseTwoPointCrossSection(1, true)
 	if p != 1 {
 		c.Fatalf("Choose inappropriate point: %v", p)
 	}
 }
 func (s *HelperSuite) Test_chooseTwoPointCrossSection_secondCrossPoint_cantCopiesOfParent(c *C) {
 	for i := 0; i < 10; i++ {
-		p1, p2 := chooseTwoPointCrossSection(2, false)
+		p1, p2 := new(randomizer).chooseTwoPointCrossSection(2, false)
 		if ((p1 != 0) || (p2 != 1)) && ((p1 != 1) || (p2 != 2)) {
 			c.Fatalf("Choose inappropriate points: %d:%d", p2, p2)
 		}
@@ -61,7 +95,7 @@ func (s *HelperSuite) Test_chooseTwoPointCrossSection_secondCrossPoint_cantCopie
 }
 
 func (s *HelperSuite) Test_chooseDifferentRandomNumbers(c *C) {
-	numbers := chooseDifferentRandomNumbers(3, 3)
+	numbers := new(randomizer).chooseDifferentRandomNumbers(3, 3)
 	sort.Sort(sort.IntSlice(numbers))
 
 	c.Assert(numbers, DeepEquals, []int{0, 1, 2})
@@ -0,0 +1,83 @@
+package genetic_algorithm
+
+import (
+	"fmt"
+	. "gopkg.in/check.v1"
+	"testing"
+)
+
+type LoggerSuite struct{}
+
+var _ = Suite(&LoggerSuite{})
+
+func (s *LoggerSuite) TestOptimizerBase_PassesLoggerToOperators(c *C) {
+	logger := newRecordingLogger(LogLevelTrace)
+
+	NewSimpleOptimizer().
+		Elitism(1).
+		CrossoverProbability(0.8).
+		Initializer(NewBinaryRandomInitializer()).
+		Selector(NewSimpleTournamentSelector(2)).
+		Crossover(NewTwoPointCrossover(NewEmptyBinaryChromosome)).
+		Mutator(NewBinaryMutator(0.05)).
+		CostFunction(countOnes).
+		StopCriterion(NewStopCriterionDefault().Max_Generations(3)).
+		Logger(logger).
+		PopSize(10).
+		ChromSize(10).
+		Optimize()
+
+	c.Assert(logger.messages[LogLevelInfo], Not(HasLen), 0)
+	c.Assert(logger.messages[LogLevelInfo][0], Equals, "GENERATION 0")
+	c.Assert(logger.messages[LogLevelTrace], Not(HasLen), 0)
+}
+func (s *LoggerSuite) TestLogging_DisabledLevelsAreNotFormatted(c *C) {
+	logger := newRecordingLogger(LogLevelInfo)
+
+	var l logging
+	l.SetLogger(logger)
+
+	l.tracef("%v", formatCounter{&logger.formatted})
+	l.debugf("%v", formatCounter{&logger.formatted})
+	l.infof("%v", formatCounter{&logger.formatted})
+
+	c.Assert(logger.formatted, Equals, 1)
+	c.Assert(logger.messages[LogLevelTrace], HasLen, 0)
+	c.Assert(logger.messages[LogLevelInfo], DeepEquals, []string{"formatted"})
+}
+func (s *LoggerSuite) TestMutator_DoesNotFormatWithoutLogger(c *C) {
+	mutator := NewBinaryMutator(1).WithoutElitism()
+	population := NewBinaryRandomInitializer().Init(5, 10)
+
+	allocs := testing.AllocsPerRun(10, func() {
+		mutator.Mutate(population)
+	})
+
+	// Only boxing of genes for Len, nothing per mutated gene
+	c.Assert(allocs <= float64(len(population)), Equals, true)
+}
+
+type recordingLogger struct {
+	minLevel  LogLevel
+	messages  map[LogLevel][]string
+	formatted int
+}
+
+func newRecordingLogger(minLevel LogLevel) *recordingLogger {
+	return &recordingLogger{minLevel: minLevel, messages: make(map[LogLevel][]string)}
+}
+func (logger *recordingLogger) Enabled(level LogLevel) bool {
+	return level >= logger.minLevel
+}
+func (logger *recordingLogger) Logf(level LogLevel, format string, args ...interface{}) {
+	logger.messages[level] = append(logger.messages[level], fmt.Sprintf(format, args...))
+}
+
+type formatCounter struct {
+	count *int
+}
+
+func (counter formatCounter) String() string {
+	*counter.count++
+	return "formatted"
+}
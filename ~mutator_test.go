@@ -38,6 +38,33 @@ func (s *MutatorSuite) TestMutatorGenesBase_Elitism(c *C) {
 	c.Assert(pop[2].Genes(), DeepEquals, falseGenes)
 }
 
+func (s *MutatorSuite) TestMutatorGenesBase_MarksDirty(c *C) {
+	pop := Chromosomes{
+		NewBinaryChromosome(BinaryGenes{false}),
+		NewBinaryChromosome(BinaryGenes{false}),
+	}
+	pop[0].SetCost(0)
+	pop[1].SetCost(0)
+
+	NewBinaryMutator(1).WithElitism(1).Mutate(pop)
+
+	c.Assert(pop[0].(*BinaryChromosome).Dirty(), Equals, false)
+	c.Assert(pop[1].(*BinaryChromosome).Dirty(), Equals, true)
+}
+
+func (s *MutatorSuite) TestMutateOffspring_MutatesAllChildren(c *C) {
+	for _, mutator := range []*MutatorGeneBase{NewBinaryMutator(1), NewBinaryMutator(1).WithElitism(3)} {
+		offspring := Chromosomes{
+			NewBinaryChromosome(BinaryGenes{false}),
+			NewBinaryChromosome(BinaryGenes{false}),
+		}
+
+		mutateOffspring(mutator, offspring)
+
+		c.Assert(offspring[0].Genes(), DeepEquals, BinaryGenes{true})
+		c.Assert(offspring[1].Genes(), DeepEquals, BinaryGenes{true})
+	}
+}
 func (s *MutatorSuite) TestSwapMutator_chooseSecondInd(c *C) {
 	mutator := new(SwapMutator)
 
@@ -153,3 +180,94 @@ func (s *MutatorSuite) TestInvertSwapMutator_indexes(c *C) {
 		}
 	}
 }
+
+func (s *MutatorSuite) TestRealMutators_RespectBounds(c *C) {
+	bounds := NewRealBounds([]float64{0, -1, 5}, []float64{1, 1, 5})
+
+	mutators := []*MutatorGeneBase{
+		NewGaussianMutator(1, 10),
+		NewPerGeneGaussianMutator(1, []float64{1, 2, 3}),
+		NewPolynomialMutator(1, 20),
+		NewUniformResetMutator(1),
+		NewNonUniformMutator(1, 10, 5),
+	}
+
+	for _, mutator := range mutators {
+		pop := Chromosomes{
+			NewRealChromosome(RealGenes{0, 1, 5}, bounds),
+			NewRealChromosome(RealGenes{1, -1, 5}, bounds),
+			NewRealChromosome(RealGenes{0.5, 0, 5}, bounds),
+		}
+
+		for i := 0; i < 10; i++ {
+			mutator.WithoutElitism().Mutate(pop)
+
+			for _, chrom := range pop {
+				assertRealGenesWithinBounds(c, chrom, bounds)
+			}
+		}
+	}
+}
+func (s *MutatorSuite) TestPolynomialMutator_deltaq(c *C) {
+	mutator := &PolynomialMutator{distributionIndex: 20}
+
+	c.Assert(mutator.deltaq(0.5, 0.5, 0.5), Within, 1e-9, 0.0)
+	c.Assert(mutator.deltaq(0, 0.3, 0.7), Within, 1e-9, -0.3)
+	c.Assert(mutator.deltaq(1, 0.3, 0.7), Within, 1e-9, 0.7)
+}
+func (s *MutatorSuite) TestNonUniformMutator_StepShrinks(c *C) {
+	mutator := &NonUniformMutator{maxGenerations: 10, shape: 5}
+
+	mutator.SetGeneration(0)
+	c.Assert(mutator.delta(1, 0), Equals, 1.0)
+
+	mutator.SetGeneration(5)
+	first := mutator.delta(1, 0.5)
+	mutator.SetGeneration(8)
+	second := mutator.delta(1, 0.5)
+	c.Assert(second < first, Equals, true)
+
+	mutator.SetGeneration(10)
+	c.Assert(mutator.delta(1, 0.5), Equals, 0.0)
+}
+func (s *MutatorSuite) TestMutatorGeneBase_PassesGeneration(c *C) {
+	mutator := NewNonUniformMutator(1, 10, 5)
+
+	mutator.SetGeneration(7)
+
+	c.Assert(mutator.MutatorGeneBaseVirtualMInterface.(*NonUniformMutator).generation, Equals, 7)
+}
+
+func (s *MutatorSuite) TestIntegerMutators_RespectBounds(c *C) {
+	bounds := NewIntegerBounds([]int{0, -1, 5}, []int{3, 1, 5})
+
+	mutators := []*MutatorGeneBase{
+		NewIntegerRandomResetMutator(1),
+		NewIntegerCreepMutator(1, 2),
+	}
+
+	for _, mutator := range mutators {
+		pop := Chromosomes{
+			NewIntegerChromosome(IntegerGenes{0, 1, 5}, bounds),
+			NewIntegerChromosome(IntegerGenes{3, -1, 5}, bounds),
+		}
+
+		for i := 0; i < 10; i++ {
+			mutator.WithoutElitism().Mutate(pop)
+
+			for _, chrom := range pop {
+				assertIntegerGenesWithinBounds(c, chrom, bounds)
+			}
+		}
+	}
+}
+func (s *MutatorSuite) TestIntegerRandomResetMutator_ChangesGene(c *C) {
+	bounds := NewUniformIntegerBounds(0, 1)
+	chrom := NewIntegerChromosome(IntegerGenes{0, 1}, bounds)
+
+	mutator := new(IntegerRandomResetMutator)
+	mutator.MutateCromosome(chrom, 0)
+	mutator.MutateCromosome(chrom, 1)
+
+	c.Assert(chrom.IntegerGenes(), DeepEquals, IntegerGenes{1, 0})
+}
@@ -0,0 +1,1258 @@
+package genetic_algorithm
+
+import (
+	"bytes"
+	"context"
+	. "gopkg.in/check.v1"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+type OptimizerSuite struct{}
+
+var _ = Suite(&OptimizerSuite{})
+
+func (s *OptimizerSuite) TestOptimizerBase_SkipsUnchangedChromosomes(c *C) {
+	evaluations := 0
+	cost := func(chrom ChromosomeInterface) float64 {
+		evaluations++
+		return countOnes(chrom)
+	}
+
+	_, data := NewSimpleOptimizer().
+		Elitism(2).
+		CrossoverProbability(0.5).
+		Initializer(NewBinaryRandomInitializer()).
+		Selector(NewSimpleTournamentSelector(2)).
+		Crossover(NewOnePointCrossover(NewEmptyBinaryChromosome)).
+		Mutator(NewBinaryMutator(0.01)).
+		CostFunction(cost).
+		StopCriterion(NewStopCriterionDefault().Max_Generations(10)).
+		StatisticsOptions(NewStatisticsDefaultOptions().TrackEvaluations()).
+		PopSize(10).
+		ChromSize(10).
+		Optimize()
+
+	stats := data.(StatisticsDataDefault)
+	c.Assert(stats.Evaluations(), Equals, evaluations)
+	c.Assert(stats.SkippedEvaluations() > 0, Equals, true)
+	c.Assert(stats.Evaluations()+stats.SkippedEvaluations(), Equals, 10*11)
+}
+func (s *OptimizerSuite) TestOptimizerBase_CostCache(c *C) {
+	evaluations := 0
+	cost := func(chrom ChromosomeInterface) float64 {
+		evaluations++
+		return countOnes(chrom)
+	}
+
+	_, data := NewSimpleOptimizer().
+		Elitism(1).
+		CrossoverProbability(1).
+		Initializer(NewBinaryRandomInitializer()).
+		Selector(NewSimpleTournamentSelector(2)).
+		Crossover(NewOnePointCrossover(NewEmptyBinaryChromosome)).
+		Mutator(NewBinaryMutator(0.1)).
+		CostFunction(cost).
+		StopCriterion(NewStopCriterionDefault().Max_Generations(20)).
+		StatisticsOptions(NewStatisticsDefaultOptions().TrackEvaluations().TrackCostCache()).
+		CostCache(100).
+		PopSize(10).
+		ChromSize(3).
+		Optimize()
+
+	stats := data.(StatisticsDataDefault)
+	c.Assert(stats.CostCacheMisses(), Equals, evaluations)
+	c.Assert(stats.CostCacheHits() > 0, Equals, true)
+	c.Assert(stats.CostCacheHits()+stats.CostCacheMisses(), Equals, stats.Evaluations())
+}
+func (s *OptimizerSuite) TestOptimizerBase_SameSeedsGiveSameResults(c *C) {
+	constructors := []func(seed int64) OptimizerInterface{
+		func(seed int64) OptimizerInterface {
+			return NewSimpleOptimizer().
+				Elitism(1).
+				CrossoverProbability(0.8).
+				Initializer(NewBinaryRandomInitializer()).
+				Selector(NewRouletteWheelRankWeightingSelector()).
+				Crossover(NewTwoPointCrossover(NewEmptyBinaryChromosome)).
+				Mutator(NewBinaryMutator(0.05)).
+				CostFunction(countOnes).
+				StopCriterion(NewStopCriterionDefault().Max_Generations(30)).
+				StatisticsOptions(NewStatisticsDefaultOptions().TrackMinCosts()).
+				PopSize(20).
+				ChromSize(30).
+				Seed(seed)
+		},
+		func(seed int64) OptimizerInterface {
+			return NewIncrementalOptimizer().
+				Weeder(NewSimpleWeeder(50)).
+				Initializer(NewOrderedRandomInitializer()).
+				Selector(NewTournamentSelector(0.8, 3)).
+				Crossover(NewEdgeRecombinationCrossover()).
+				Mutator(NewInvertDisplacementMutator(0.2, NewEmptyOrderedChromosome)).
+				CostFunction(displacement).
+				StopCriterion(NewStopCriterionDefault().Max_Generations(30)).
+				StatisticsOptions(NewStatisticsDefaultOptions().TrackMinCosts()).
+				PopSize(20).
+				ChromSize(15).
+				Seed(seed)
+		},
+		func(seed int64) OptimizerInterface {
+			bounds := NewUniformRealBounds(-5, 5)
+			return NewSimpleOptimizer().
+				Elitism(2).
+				CrossoverProbability(0.9).
+				Initializer(NewRealRandomInitializer(bounds)).
+				Selector(NewSimpleTournamentSelector(2)).
+				Crossover(NewSimulatedBinaryCrossover(10)).
+				Mutator(NewPolynomialMutator(0.1, 20)).
+				CostFunction(sumOfSquares).
+				StopCriterion(NewStopCriterionDefault().Max_Generations(30)).
+				StatisticsOptions(NewStatisticsDefaultOptions().TrackMinCosts()).
+				PopSize(20).
+				ChromSize(5).
+				Seed(seed)
+		},
+	}
+
+	for _, constructor := range constructors {
+		_, data1 := constructor(42).Optimize()
+		_, data2 := constructor(42).Optimize()
+
+		c.Assert(data1.(StatisticsDataDefault).MinCosts(), DeepEquals, data2.(StatisticsDataDefault).MinCosts())
+	}
+}
+func (s *OptimizerSuite) TestOptimizerBase_SeededRunsAreReproducible(c *C) {
+	optimizer := func() OptimizerInterface {
+		return NewSimpleOptimizer().
+			Elitism(1).
+			CrossoverProbability(0.8).
+			Initializer(NewBinaryRandomInitializer()).
+			Selector(NewRandomSelector()).
+			Crossover(NewUniformCrossover(NewEmptyBinaryChromosome, 0.5)).
+			Mutator(NewBinaryMutator(0.05)).
+			CostFunction(countOnes).
+			StopCriterion(NewStopCriterionDefault().Max_Generations(10)).
+			StatisticsOptions(NewStatisticsDefaultOptions().TrackMinCosts()).
+			PopSize(10).
+			ChromSize(20).
+			Seed(7)
+	}
+
+	optimizer1 := optimizer()
+	optimizer2 := optimizer()
+
+	for i := 0; i < 3; i++ {
+		_, data1 := optimizer1.Optimize()
+		_, data2 := optimizer2.Optimize()
+
+		c.Assert(data1.(StatisticsDataDefault).MinCosts(), DeepEquals, data2.(StatisticsDataDefault).MinCosts())
+	}
+}
+
+func (s *OptimizerSuite) TestOptimizerBase_ResumedRunMatchesUninterrupted(c *C) {
+	options := func() StatisticsOptionsInterface {
+		return NewStatisticsDefaultOptions().TrackMinCosts().TrackMeanCosts().TrackEvaluations()
+	}
+	bounds := NewUniformRealBounds(-5, 5)
+	constructors := []func() *OptimizerBase{
+		func() *OptimizerBase {
+			return NewSimpleOptimizer().
+				Elitism(1).
+				CrossoverProbability(0.8).
+				Initializer(NewBinaryRandomInitializer()).
+				Selector(NewSimpleTournamentSelector(2)).
+				Crossover(NewTwoPointCrossover(NewEmptyBinaryChromosome)).
+				Mutator(NewBinaryMutator(0.05)).
+				CostFunction(countOnes).
+				StopCriterion(NewStopCriterionDefault().Max_Generations(20)).
+				StatisticsOptions(options()).
+				PopSize(20).
+				ChromSize(30).
+				Seed(3)
+		},
+		func() *OptimizerBase {
+			return NewIncrementalOptimizer().
+				Weeder(NewSimpleWeeder(50)).
+				Initializer(NewOrderedRandomInitializer()).
+				Selector(NewTournamentSelector(0.8, 3)).
+				Crossover(NewEdgeRecombinationCrossover()).
+				Mutator(NewInvertDisplacementMutator(0.2, NewEmptyOrderedChromosome)).
+				CostFunction(displacement).
+				StopCriterion(NewStopCriterionDefault().Max_Generations(20)).
+				StatisticsOptions(options()).
+				PopSize(20).
+				ChromSize(15).
+				Seed(3)
+		},
+		func() *OptimizerBase {
+			return NewSimpleOptimizer().
+				Elitism(2).
+				CrossoverProbability(0.9).
+				Initializer(NewRealRandomInitializer(bounds)).
+				Selector(NewSimpleTournamentSelector(2)).
+				Crossover(NewSimulatedBinaryCrossover(10)).
+				Mutator(NewPolynomialMutator(0.1, 20)).
+				CostFunction(sumOfSquares).
+				StopCriterion(NewStopCriterionDefault().Max_Generations(20)).
+				StatisticsOptions(options()).
+				ChromosomeSerializer(NewRealChromosomeSerializer(bounds)).
+				PopSize(20).
+				ChromSize(5).
+				Seed(3)
+		},
+		// Without elitism the best chromosome can be lost before the checkpoint
+		func() *OptimizerBase {
+			return NewSimpleOptimizer().
+				Elitism(0).
+				CrossoverProbability(0.8).
+				Initializer(NewBinaryRandomInitializer()).
+				Selector(NewSimpleTournamentSelector(2)).
+				Crossover(NewTwoPointCrossover(NewEmptyBinaryChromosome)).
+				Mutator(NewBinaryMutator(0.2).WithoutElitism()).
+				ConstrainedCostFunction(func(chrom ChromosomeInterface) ConstrainedCost {
+					ones := countOnes(chrom)
+					return ConstrainedCost{Objective: ones, Violation: math.Max(0, 10-ones)}
+				}).
+				ConstraintHandler(NewAdaptivePenalty(1, 2)).
+				StopCriterion(AnyOf(
+					NewStopCriterionDefault().Max_Generations(20),
+					NewWallClockStopCriterion(time.Hour),
+					NewSignalStopCriterion(nil))).
+				StatisticsOptions(options()).
+				PopSize(20).
+				ChromSize(30).
+				Seed(3)
+		},
+	}
+
+	for _, constructor := range constructors {
+		checkpoints := make(map[int]*bytes.Buffer)
+		best1, data1 := constructor().
+			Checkpoint(5, func(generation int) (io.Writer, error) {
+				checkpoints[generation] = new(bytes.Buffer)
+				return checkpoints[generation], nil
+			}).
+			Optimize()
+
+		c.Assert(len(checkpoints), Equals, 4)
+
+		optimizer := constructor()
+		c.Assert(optimizer.Resume(checkpoints[10]), IsNil)
+		best2, data2 := optimizer.Optimize()
+
+		stats1 := data1.(StatisticsDataDefault)
+		stats2 := data2.(StatisticsDataDefault)
+		c.Assert(stats2.Generations(), Equals, stats1.Generations())
+		c.Assert(stats2.MinCosts(), DeepEquals, stats1.MinCosts())
+		c.Assert(stats2.MeanCosts(), DeepEquals, stats1.MeanCosts())
+		c.Assert(stats2.Evaluations(), Equals, stats1.Evaluations())
+		c.Assert(best2.Genes(), DeepEquals, best1.Genes())
+		c.Assert(best2.Cost(), Equals, best1.Cost())
+	}
+}
+func (s *OptimizerSuite) TestOptimizerBase_ResumeRestoresStopCriteria(c *C) {
+	now := time.Now()
+	clock := NewWallClockStopCriterion(time.Minute)
+	clock.now = func() time.Time { return now }
+	signal := make(chan struct{}, 1)
+	signaled := NewSignalStopCriterion(signal)
+	criterion := AllOf(NewEvaluationBudgetStopCriterion(100), clock, signaled)
+
+	criterion.Setup(NewStatisticsDefaultOptions())
+	signal <- struct{}{}
+	now = now.Add(40 * time.Second)
+	c.Assert(clock.ShouldStop(nil), Equals, false)
+	c.Assert(signaled.ShouldStop(nil), Equals, true)
+
+	data, err := criterion.MarshalCheckpoint()
+	c.Assert(err, IsNil)
+
+	resumedClock := NewWallClockStopCriterion(time.Minute)
+	resumedClock.now = func() time.Time { return now }
+	resumedSignal := NewSignalStopCriterion(nil)
+	resumed := AllOf(NewEvaluationBudgetStopCriterion(100), resumedClock, resumedSignal)
+	resumed.Setup(NewStatisticsDefaultOptions())
+	c.Assert(resumed.UnmarshalCheckpoint(data), IsNil)
+
+	c.Assert(resumedSignal.ShouldStop(nil), Equals, true)
+	c.Assert(resumedClock.ShouldStop(nil), Equals, false)
+	now = now.Add(20 * time.Second)
+	c.Assert(resumedClock.ShouldStop(nil), Equals, true)
+}
+func (s *OptimizerSuite) TestNSGA2Optimizer_ResumedRunMatchesUninterrupted(c *C) {
+	evaluations := 0
+	cost := func(chrom ChromosomeInterface) MultiCost {
+		evaluations++
+		ones := countOnes(chrom)
+		return MultiCost{ones, 10 - ones}
+	}
+	optimizer := func() *NSGA2Optimizer {
+		optimizer := NewNSGA2Optimizer().MultiCostFunction(cost)
+		optimizer.
+			Initializer(NewBinaryRandomInitializer()).
+			Crossover(NewTwoPointCrossover(NewEmptyBinaryChromosome)).
+			Mutator(NewBinaryMutator(0.05).WithoutElitism()).
+			StopCriterion(NewStopCriterionDefault().Max_Generations(20)).
+			PopSize(10).
+			ChromSize(10).
+			Seed(4)
+		return optimizer
+	}
+
+	var checkpoint bytes.Buffer
+	interrupted := optimizer()
+	interrupted.Checkpoint(10, func(generation int) (io.Writer, error) {
+		if generation != 10 {
+			return new(bytes.Buffer), nil
+		}
+		return &checkpoint, nil
+	})
+	front1, _ := interrupted.Optimize()
+	// Offspring of generations from 10 to 20
+	evaluationsAfterCheckpoint := 11 * 10
+
+	resumed := optimizer()
+	c.Assert(resumed.Resume(&checkpoint), IsNil)
+	evaluations = 0
+	front2, _ := resumed.Optimize()
+
+	c.Assert(evaluations, Equals, evaluationsAfterCheckpoint)
+	c.Assert(len(front2), Equals, len(front1))
+	for i := range front1 {
+		c.Assert(front2[i].Chromosome.Genes(), DeepEquals, front1[i].Chromosome.Genes())
+		c.Assert(front2[i].Cost, DeepEquals, front1[i].Cost)
+	}
+}
+func (s *OptimizerSuite) TestOptimizerBase_ResumeRequiresSerializer(c *C) {
+	bounds := NewUniformIntegerBounds(0, 9)
+	checkpoints := 0
+
+	optimizer := NewSimpleOptimizer().
+		Elitism(1).
+		CrossoverProbability(0.8).
+		Initializer(NewIntegerRandomInitializer(bounds)).
+		Selector(NewSimpleTournamentSelector(2)).
+		Crossover(NewTwoPointCrossover(NewEmptyIntegerChromosomeConstructor(bounds))).
+		Mutator(NewIntegerRandomResetMutator(0.1)).
+		CostFunction(func(ChromosomeInterface) float64 { return 0 }).
+		StopCriterion(NewStopCriterionDefault().Max_Generations(5)).
+		PopSize(10).
+		ChromSize(5)
+
+	var buffer bytes.Buffer
+	optimizer.Checkpoint(2, func(generation int) (io.Writer, error) {
+		checkpoints++
+		return &buffer, nil
+	}).Optimize()
+
+	c.Assert(checkpoints, Equals, 2)
+	c.Assert(buffer.Len(), Equals, 0)
+
+	optimizer.ChromosomeSerializer(NewIntegerChromosomeSerializer(bounds)).Optimize()
+	c.Assert(buffer.Len() > 0, Equals, true)
+	c.Assert(optimizer.Resume(&buffer), IsNil)
+}
+
+func (s *OptimizerSuite) TestOptimizerBase_OptimizeContext_ReturnsConfigErrors(c *C) {
+	optimizer := func() *OptimizerBase {
+		bounds := NewUniformRealBounds(-1, 1)
+		return NewSimpleOptimizer().
+			Elitism(1).
+			CrossoverProbability(0.8).
+			Initializer(NewRealRandomInitializer(bounds)).
+			Selector(NewSimpleTournamentSelector(2)).
+			Crossover(NewBlendCrossover(0.5)).
+			Mutator(NewGaussianMutator(0.1, 0.1)).
+			CostFunction(sumOfSquares).
+			StopCriterion(NewStopCriterionDefault().Max_Generations(5)).
+			PopSize(10).
+			ChromSize(3)
+	}
+
+	_, _, err := optimizer().OptimizeContext(context.Background())
+	c.Assert(err, IsNil)
+
+	_, _, err = optimizer().PopSize(0).OptimizeContext(context.Background())
+	c.Assert(err, FitsTypeOf, &ConfigError{})
+	c.Assert(err.(*ConfigError).Component, Equals, "Optimizer")
+
+	_, _, err = optimizer().Crossover(NewBlendCrossover(-1)).OptimizeContext(context.Background())
+	c.Assert(err, FitsTypeOf, &ConfigError{})
+	c.Assert(err.(*ConfigError).Component, Equals, "BlendCrossover")
+
+	_, _, err = optimizer().Mutator(NewGaussianMutator(2, 0.1)).OptimizeContext(context.Background())
+	c.Assert(err, ErrorMatches, "Mutator: Incorrect probability 2")
+
+	_, _, err = optimizer().Mutator(NewPerGeneGaussianMutator(0.1, []float64{0.1, 0.2})).OptimizeContext(context.Background())
+	c.Assert(err, ErrorMatches, "GaussianMutator: Expected 1 or 3 sigmas, got 2")
+
+	_, _, err = optimizer().Mutator(NewPerGeneGaussianMutator(0.1, []float64{0.1, 0.2, 0.3})).OptimizeContext(context.Background())
+	c.Assert(err, IsNil)
+
+	_, _, err = optimizer().Initializer(NewRealRandomInitializer(NewUniformRealBounds(1, -1))).OptimizeContext(context.Background())
+	c.Assert(err, ErrorMatches, "RealBounds: .*")
+
+	_, _, err = optimizer().Initializer(NewRealRandomInitializer(NewRealBounds([]float64{0, 0}, []float64{1, 1}))).OptimizeContext(context.Background())
+	c.Assert(err, ErrorMatches, "RealRandomInitializer: Bounds do not fit .*")
+
+	_, _, err = optimizer().Crossover(NewOnePointCrossover(NewEmptyRealChromosomeConstructor(NewUniformRealBounds(-1, 1)))).
+		ChromSize(1).OptimizeContext(context.Background())
+	c.Assert(err, ErrorMatches, "MultiPointCrossover: Chromosome of length 1 is too short .*")
+
+	_, _, err = optimizer().Crossover(NewMaskCrossover(NewEmptyRealChromosomeConstructor(NewUniformRealBounds(-1, 1)),
+		func(genesLen int) []bool { return make([]bool, genesLen+1) })).OptimizeContext(context.Background())
+	c.Assert(err, ErrorMatches, "MaskCrossover: Mask length 4 differs .*")
+
+	_, _, err = optimizer().Crossover(NewWholeArithmeticCrossover().Alpha(1)).OptimizeContext(context.Background())
+	c.Assert(err, ErrorMatches, "ArithmeticCrossover: Crossover can only produce copies of parents .*")
+
+	_, _, err = optimizer().Crossover(NewWholeArithmeticCrossover().Alpha(1).CanProduceCopiesOfParents(true)).OptimizeContext(context.Background())
+	c.Assert(err, IsNil)
+
+	_, _, err = optimizer().Crossover(NewPartiallyMappedCrossover()).OptimizeContext(context.Background())
+	c.Assert(err, ErrorMatches, "PartiallyMappedCrossover: Expected OrderedChromosome, got \\*genetic_algorithm.RealChromosome")
+
+	_, _, err = optimizer().Mutator(NewBinaryMutator(0.1)).OptimizeContext(context.Background())
+	c.Assert(err, ErrorMatches, "BinaryMutator: Expected BinaryChromosome, got .*")
+
+	_, _, err = optimizer().Initializer(NewOrderedRandomInitializer()).Crossover(NewPartiallyMappedCrossover()).
+		Mutator(NewSwapMutator(0.1)).ChromSize(1).OptimizeContext(context.Background())
+	c.Assert(err, ErrorMatches, "PartiallyMappedCrossover: Crossover can only produce copies of parents if genesLen < 2")
+
+	c.Assert(func() { optimizer().PopSize(0).Optimize() }, PanicMatches, "Optimizer: PopSize.*")
+}
+func (s *OptimizerSuite) TestOptimizerBase_OptimizeContext_StopsWhenCancelled(c *C) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	generations := 0
+	cost := func(chrom ChromosomeInterface) float64 {
+		return countOnes(chrom)
+	}
+
+	best, data, err := NewSimpleOptimizer().
+		Elitism(1).
+		CrossoverProbability(0.8).
+		Initializer(NewBinaryRandomInitializer()).
+		Selector(NewSimpleTournamentSelector(2)).
+		Crossover(NewTwoPointCrossover(NewEmptyBinaryChromosome)).
+		Mutator(NewBinaryMutator(0.05)).
+		CostFunction(cost).
+		StopCriterion(stopCriterionFunc(func(data StatisticsDataInterface) bool {
+			generations++
+			if generations == 5 {
+				cancel()
+			}
+			return false
+		})).
+		PopSize(10).
+		ChromSize(10).
+		OptimizeContext(ctx)
+
+	c.Assert(err, Equals, context.Canceled)
+	c.Assert(best, NotNil)
+	c.Assert(data.(StatisticsDataDefault).Generations(), Equals, 4)
+
+	_, _, err = NewOptimizerAggregator().
+		Optimizer(NewSimpleOptimizer().
+			Elitism(1).
+			CrossoverProbability(0.8).
+			Initializer(NewBinaryRandomInitializer()).
+			Selector(NewSimpleTournamentSelector(2)).
+			Crossover(NewTwoPointCrossover(NewEmptyBinaryChromosome)).
+			Mutator(NewBinaryMutator(0.05)).
+			CostFunction(cost).
+			StopCriterion(NewStopCriterionDefault().Max_Generations(5)).
+			PopSize(10).
+			ChromSize(10)).
+		Iterations(3).
+		OptimizeContext(ctx)
+	c.Assert(err, Equals, context.Canceled)
+}
+
+func (s *OptimizerSuite) TestOptimizerBase_NotifiesObservers(c *C) {
+	observer1 := new(recordingObserver)
+	observer2 := new(recordingObserver)
+
+	best, data := NewSimpleOptimizer().
+		Elitism(1).
+		CrossoverProbability(0.8).
+		Initializer(NewBinaryRandomInitializer()).
+		Selector(NewSimpleTournamentSelector(2)).
+		Crossover(NewTwoPointCrossover(NewEmptyBinaryChromosome)).
+		Mutator(NewBinaryMutator(0.05)).
+		CostFunction(countOnes).
+		StopCriterion(NewStopCriterionDefault().Max_Generations(10)).
+		StatisticsOptions(NewStatisticsDefaultOptions().TrackMinCosts()).
+		Observer(observer1).
+		Observer(observer2).
+		PopSize(10).
+		ChromSize(20).
+		Optimize()
+
+	minCosts := data.(StatisticsDataDefault).MinCosts()
+	for _, observer := range []*recordingObserver{observer1, observer2} {
+		c.Assert(observer.events[0], Equals, "start")
+		c.Assert(observer.events[len(observer.events)-1], Equals, "end")
+		c.Assert(observer.generations, DeepEquals, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+		c.Assert(observer.best, Equals, best)
+		c.Assert(observer.err, IsNil)
+
+		c.Assert(observer.bestCosts[0], Equals, minCosts[0])
+		c.Assert(observer.bestCosts[len(observer.bestCosts)-1], Equals, best.Cost())
+		for i := 1; i < len(observer.bestCosts); i++ {
+			c.Assert(observer.bestCosts[i] < observer.bestCosts[i-1], Equals, true)
+		}
+	}
+}
+func (s *OptimizerSuite) TestOptimizerBase_ReturnsBestFoundSoFar(c *C) {
+	// Without elitism and with strong mutation the best chromosome is lost in the next generations
+	best, data := NewSimpleOptimizer().
+		CrossoverProbability(0.8).
+		Initializer(NewBinaryRandomInitializer()).
+		Selector(NewSimpleTournamentSelector(2)).
+		Crossover(NewTwoPointCrossover(NewEmptyBinaryChromosome)).
+		Mutator(NewBinaryMutator(0.3).WithoutElitism()).
+		CostFunction(countOnes).
+		StopCriterion(NewStopCriterionDefault().Max_Generations(20)).
+		StatisticsOptions(NewStatisticsDefaultOptions().TrackMinCosts()).
+		PopSize(10).
+		ChromSize(20).
+		Seed(1).
+		Optimize()
+
+	minCosts := data.(StatisticsDataDefault).MinCosts()
+	bestCost := minCosts[0]
+	for _, cost := range minCosts {
+		bestCost = math.Min(bestCost, cost)
+	}
+	c.Assert(bestCost < minCosts[len(minCosts)-1], Equals, true)
+	c.Assert(best.Cost(), Equals, bestCost)
+	c.Assert(countOnes(best), Equals, bestCost)
+}
+
+func (s *OptimizerSuite) TestSteadyStateOptimizer(c *C) {
+	replacements := []ReplacementInterface{
+		NewReplaceWorst(),
+		NewReverseTournamentReplacement(3),
+		NewReplaceParentIfBetter(),
+	}
+
+	for _, replacement := range replacements {
+		observer := new(recordingObserver)
+		sorted := true
+
+		best, data := NewSteadyStateOptimizer().
+			Replacement(replacement).
+			OffspringPerStep(3).
+			Initializer(NewBinaryRandomInitializer()).
+			Selector(NewSimpleTournamentSelector(2)).
+			Crossover(NewTwoPointCrossover(NewEmptyBinaryChromosome)).
+			Mutator(NewBinaryMutator(0.05).WithoutElitism()).
+			CostFunction(countOnes).
+			StopCriterion(NewStopCriterionDefault().Max_Generations(50)).
+			StatisticsOptions(NewStatisticsDefaultOptions().TrackMinCosts().TrackEvaluations()).
+			Observer(observer).
+			Observer(&populationObserver{onGeneration: func(population Chromosomes) {
+				sorted = sorted && len(population) == 10 && sort.IsSorted(population)
+			}}).
+			PopSize(10).
+			ChromSize(20).
+			Seed(5).
+			Optimize()
+
+		stats := data.(StatisticsDataDefault)
+		c.Assert(sorted, Equals, true)
+		c.Assert(stats.Evaluations(), Equals, 10+50*3)
+		c.Assert(best.Cost(), Equals, stats.MinCost())
+
+		minCosts := stats.MinCosts()
+		for i := 1; i < len(minCosts); i++ {
+			c.Assert(minCosts[i] <= minCosts[i-1], Equals, true)
+		}
+		c.Assert(minCosts[len(minCosts)-1] < minCosts[0], Equals, true)
+	}
+}
+
+func (s *OptimizerSuite) TestIslandOptimizer(c *C) {
+	topologies := []TopologyInterface{
+		NewRingTopology(),
+		NewFullyConnectedTopology(),
+		NewRandomTopology(2),
+		NewCustomTopology([][]int{{1}, {2}, {0, 1}}),
+	}
+
+	for _, topology := range topologies {
+		optimizer := newTestIslandOptimizer(7).Topology(topology)
+
+		best, data := optimizer.Optimize()
+
+		stats := data.(StatisticsDataDefault)
+		c.Assert(best.Cost(), Equals, stats.MinCost())
+		c.Assert(stats.Generations(), Equals, 30)
+		c.Assert(stats.Evaluations() > 0, Equals, true)
+
+		islandStats := optimizer.IslandStatistics()
+		c.Assert(islandStats, HasLen, 3)
+
+		minCost, evaluations := islandStats[0].(StatisticsDataDefault).MinCost(), 0
+		for _, data := range islandStats {
+			islandData := data.(StatisticsDataDefault)
+			if islandData.MinCost() < minCost {
+				minCost = islandData.MinCost()
+			}
+			evaluations += islandData.Evaluations()
+		}
+		c.Assert(stats.MinCost(), Equals, minCost)
+		c.Assert(stats.Evaluations(), Equals, evaluations)
+	}
+}
+func (s *OptimizerSuite) TestIslandOptimizer_SameSeedsGiveSameResults(c *C) {
+	_, data1 := newTestIslandOptimizer(3).Optimize()
+	_, data2 := newTestIslandOptimizer(3).Optimize()
+
+	c.Assert(data1.(StatisticsDataDefault).MinCosts(), DeepEquals, data2.(StatisticsDataDefault).MinCosts())
+}
+func (s *OptimizerSuite) TestIslandOptimizer_Migration(c *C) {
+	islands := make([]*OptimizerBase, 2)
+	for i := range islands {
+		islands[i] = NewSimpleOptimizer().
+			Elitism(1).
+			CrossoverProbability(0.8).
+			Initializer(NewBinaryRandomInitializer()).
+			Selector(NewSimpleTournamentSelector(2)).
+			Crossover(NewTwoPointCrossover(NewEmptyBinaryChromosome)).
+			Mutator(NewBinaryMutator(0.05)).
+			CostFunction(countOnes).
+			StopCriterion(NewStopCriterionDefault().Max_Generations(20)).
+			StatisticsOptions(NewStatisticsDefaultOptions().TrackMinCosts()).
+			PopSize(10).
+			ChromSize(20).
+			Seed(int64(i + 1))
+	}
+	optimizer := NewIslandOptimizer().
+		Island(islands[0]).
+		Island(islands[1]).
+		Topology(NewCustomTopology([][]int{{1}, {}})).
+		MigrationInterval(5).
+		MigrantCount(2).
+		MigrantSelector(NewSimpleTournamentSelector(10)).
+		MigrantConstructor(NewEmptyBinaryChromosome).
+		Seed(1)
+	optimizer.Optimize()
+
+	first := optimizer.IslandStatistics()[0].(StatisticsDataDefault).MinCosts()
+	second := optimizer.IslandStatistics()[1].(StatisticsDataDefault).MinCosts()
+	// The best chromosome of the first island migrates after every fifth generation
+	for i := 5; i < len(second); i += 5 {
+		c.Assert(second[i] <= first[i-1], Equals, true)
+	}
+}
+func (s *OptimizerSuite) TestIslandOptimizer_StoppedIslandsAreNotEvaluated(c *C) {
+	optimizer := NewIslandOptimizer().
+		MigrantConstructor(NewEmptyBinaryChromosome).
+		Seed(1)
+	for _, generations := range []int{3, 10} {
+		optimizer.Island(NewSimpleOptimizer().
+			Elitism(1).
+			CrossoverProbability(0.8).
+			Initializer(NewBinaryRandomInitializer()).
+			Selector(NewSimpleTournamentSelector(2)).
+			Crossover(NewTwoPointCrossover(NewEmptyBinaryChromosome)).
+			Mutator(NewBinaryMutator(0.05)).
+			CostFunction(countOnes).
+			StopCriterion(NewStopCriterionDefault().Max_Generations(generations)).
+			StatisticsOptions(NewStatisticsDefaultOptions().TrackMinCosts()).
+			PopSize(10).
+			ChromSize(20))
+	}
+
+	_, data := optimizer.Optimize()
+
+	c.Assert(data.(StatisticsDataDefault).Generations(), Equals, 10)
+	c.Assert(optimizer.IslandStatistics()[0].(StatisticsDataDefault).Generations(), Equals, 3)
+	c.Assert(optimizer.IslandStatistics()[0].(StatisticsDataDefault).MinCosts(), HasLen, 4)
+	c.Assert(optimizer.IslandStatistics()[1].(StatisticsDataDefault).Generations(), Equals, 10)
+}
+func (s *OptimizerSuite) TestIslandOptimizer_ReturnsConfigErrors(c *C) {
+	_, _, err := NewIslandOptimizer().OptimizeContext(context.Background())
+	c.Assert(err, FitsTypeOf, &ConfigError{})
+
+	_, _, err = newTestIslandOptimizer(1).MigrationInterval(0).OptimizeContext(context.Background())
+	c.Assert(err, FitsTypeOf, &ConfigError{})
+
+	_, _, err = newTestIslandOptimizer(1).Topology(NewCustomTopology([][]int{{1}, {0}})).OptimizeContext(context.Background())
+	c.Assert(err, FitsTypeOf, &ConfigError{})
+}
+
+func (s *OptimizerSuite) TestOptimizerAggregator_Seeds(c *C) {
+	run := func() []float64 {
+		_, data := NewOptimizerAggregator().
+			Optimizer(newTestComparedOptimizer(0.05)).
+			StatisticsOptions(NewStatisticsDefaultOptions().TrackMinCosts()).
+			Iterations(3).
+			Seeds(1, 2, 3).
+			Optimize()
+
+		var costs []float64
+		for _, run := range data.(StatisticsDataAggregated).RunsStatistics() {
+			costs = append(costs, run.MinCosts()...)
+		}
+		return costs
+	}
+
+	c.Assert(run(), DeepEquals, run())
+
+	_, _, err := NewOptimizerAggregator().
+		Optimizer(newTestComparedOptimizer(0.05)).
+		Iterations(3).
+		Seeds(1, 2).
+		OptimizeContext(context.Background())
+	c.Assert(err, FitsTypeOf, &ConfigError{})
+}
+func (s *OptimizerSuite) TestOptimizerAggregator_ParallelRunsAreDeterministic(c *C) {
+	run := func(parallelism int) ([]float64, []int) {
+		var created []int
+		var mutex sync.Mutex
+
+		_, data := NewOptimizerAggregator().
+			OptimizerConstructor(func(run int) OptimizerInterface {
+				mutex.Lock()
+				created = append(created, run)
+				mutex.Unlock()
+				return newTestComparedOptimizer(0.05)
+			}).
+			StatisticsOptions(NewStatisticsDefaultOptions().TrackMinCosts()).
+			Iterations(8).
+			Parallelism(parallelism).
+			Seed(5).
+			Optimize()
+
+		var costs []float64
+		for _, run := range data.(StatisticsDataAggregated).RunsStatistics() {
+			costs = append(costs, run.MinCosts()...)
+		}
+		sort.Ints(created)
+		return costs, created
+	}
+
+	sequential, created := run(1)
+	parallel, _ := run(4)
+
+	c.Assert(parallel, DeepEquals, sequential)
+	c.Assert(created, DeepEquals, []int{0, 1, 2, 3, 4, 5, 6, 7})
+}
+func (s *OptimizerSuite) TestOptimizerAggregator_ParallelRunsShareStatisticsOptions(c *C) {
+	options := NewStatisticsDefaultOptions()
+
+	_, data := NewOptimizerAggregator().
+		OptimizerConstructor(func(run int) OptimizerInterface {
+			return newTestComparedOptimizer(0.05).(*OptimizerBase).StatisticsOptions(options)
+		}).
+		StatisticsOptions(NewStatisticsDefaultOptions().TrackMinCosts().TrackMeanCosts()).
+		Iterations(8).
+		Parallelism(4).
+		Seed(5).
+		Optimize()
+
+	for _, run := range data.(StatisticsDataAggregated).RunsStatistics() {
+		c.Assert(run.MinCosts(), Not(HasLen), 0)
+		c.Assert(run.MeanCosts(), Not(HasLen), 0)
+	}
+}
+func (s *OptimizerSuite) TestOptimizerAggregator_CreatesOptimizersBeforeTheirRuns(c *C) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var created []int
+	_, _, err := NewOptimizerAggregator().
+		OptimizerConstructor(func(run int) OptimizerInterface {
+			created = append(created, run)
+			if run == 2 {
+				cancel()
+			}
+			return newTestComparedOptimizer(0.05)
+		}).
+		Iterations(8).
+		Seed(5).
+		OptimizeContext(ctx)
+
+	c.Assert(err, NotNil)
+	c.Assert(created, DeepEquals, []int{0, 1, 2})
+}
+func (s *OptimizerSuite) TestOptimizerAggregator_ReturnsConfigErrors(c *C) {
+	_, _, err := NewOptimizerAggregator().
+		Optimizer(newTestComparedOptimizer(0.05)).
+		Iterations(2).
+		Parallelism(2).
+		OptimizeContext(context.Background())
+	c.Assert(err, FitsTypeOf, &ConfigError{})
+
+	_, _, err = NewOptimizerAggregator().
+		OptimizerConstructor(func(run int) OptimizerInterface {
+			return newTestComparedOptimizer(0.05)
+		}).
+		Iterations(2).
+		Parallelism(0).
+		OptimizeContext(context.Background())
+	c.Assert(err, FitsTypeOf, &ConfigError{})
+
+	_, _, err = NewOptimizerAggregator().
+		OptimizerConstructor(func(run int) OptimizerInterface {
+			return NewSimpleOptimizer()
+		}).
+		Iterations(2).
+		Parallelism(2).
+		OptimizeContext(context.Background())
+	c.Assert(err, FitsTypeOf, &ConfigError{})
+}
+func (s *OptimizerSuite) TestComparison(c *C) {
+	result := NewComparison().
+		Configuration("weak", newTestComparedOptimizer(0.5)).
+		Configuration("strong", newTestComparedOptimizer(0.02)).
+		Configuration("strong copy", newTestComparedOptimizer(0.02)).
+		Iterations(10).
+		Target(2).
+		Seed(7).
+		Compare()
+
+	c.Assert(result.Configurations, HasLen, 3)
+	c.Assert(result.Configurations[2].Name, Equals, "weak")
+	c.Assert(result.Configurations[2].Rank, Equals, 3)
+	c.Assert(result.Pairs, HasLen, 3)
+
+	// Same seeds give same runs
+	c.Assert(result.Configurations[0].FinalCosts, DeepEquals, result.Configurations[1].FinalCosts)
+	same := result.Pair("strong", "strong copy")
+	c.Assert(same.WilcoxonP, Equals, 1.0)
+	c.Assert(same.EffectSize, Equals, 0.5)
+	c.Assert(same.EffectMagnitude(), Equals, "negligible")
+
+	pair := result.Pair("weak", "strong")
+	c.Assert(pair.Second.Name, Equals, "weak")
+	c.Assert(pair.MannWhitneyP < 0.05, Equals, true)
+	c.Assert(pair.EffectSize > 0.71, Equals, true)
+
+	strong := result.Configurations[0]
+	c.Assert(strong.SuccessRate > 0, Equals, true)
+	c.Assert(strong.MeanEvaluationsToTarget > 0, Equals, true)
+
+	var table bytes.Buffer
+	c.Assert(result.WriteTable(&table), IsNil)
+	lines := strings.Split(strings.TrimSpace(table.String()), "\n")
+	c.Assert(lines, HasLen, 4)
+	c.Assert(strings.HasPrefix(lines[3], "3"), Equals, true)
+	c.Assert(strings.Contains(lines[3], "weak"), Equals, true)
+}
+func (s *OptimizerSuite) TestComparison_ConfigurationConstructor(c *C) {
+	constructor := func(mutationProb float64) OptimizerConstructor {
+		return func(run int) OptimizerInterface {
+			return newTestComparedOptimizer(mutationProb)
+		}
+	}
+
+	sequential := NewComparison().
+		Configuration("weak", newTestComparedOptimizer(0.5)).
+		Configuration("strong", newTestComparedOptimizer(0.02)).
+		Iterations(6).
+		Seed(7).
+		Compare()
+	parallel := NewComparison().
+		ConfigurationConstructor("weak", constructor(0.5)).
+		ConfigurationConstructor("strong", constructor(0.02)).
+		Iterations(6).
+		Parallelism(3).
+		Seed(7).
+		Compare()
+
+	c.Assert(parallel.Configurations, HasLen, 2)
+	for i, configuration := range parallel.Configurations {
+		c.Assert(configuration.Name, Equals, sequential.Configurations[i].Name)
+		c.Assert(configuration.FinalCosts, DeepEquals, sequential.Configurations[i].FinalCosts)
+	}
+}
+func (s *OptimizerSuite) TestComparison_ReturnsConfigErrors(c *C) {
+	_, err := NewComparison().
+		Configuration("single", newTestComparedOptimizer(0.05)).
+		CompareContext(context.Background())
+	c.Assert(err, FitsTypeOf, &ConfigError{})
+
+	_, err = NewComparison().
+		Configuration("same", newTestComparedOptimizer(0.05)).
+		Configuration("same", newTestComparedOptimizer(0.1)).
+		CompareContext(context.Background())
+	c.Assert(err, FitsTypeOf, &ConfigError{})
+
+	_, err = NewComparison().
+		Configuration("shared", newTestComparedOptimizer(0.05)).
+		ConfigurationConstructor("created", func(run int) OptimizerInterface {
+			return newTestComparedOptimizer(0.1)
+		}).
+		Parallelism(2).
+		CompareContext(context.Background())
+	c.Assert(err, FitsTypeOf, &ConfigError{})
+
+	_, err = NewComparison().
+		ConfigurationConstructor("first", func(run int) OptimizerInterface {
+			return newTestComparedOptimizer(0.05)
+		}).
+		ConfigurationConstructor("unseeded", func(run int) OptimizerInterface {
+			return struct{ OptimizerInterface }{newTestComparedOptimizer(0.05)}
+		}).
+		CompareContext(context.Background())
+	c.Assert(err, FitsTypeOf, &ConfigError{})
+}
+func newTestComparedOptimizer(mutationProb float64) OptimizerInterface {
+	return NewSimpleOptimizer().
+		Elitism(1).
+		CrossoverProbability(0.8).
+		Initializer(NewBinaryRandomInitializer()).
+		Selector(NewSimpleTournamentSelector(2)).
+		Crossover(NewTwoPointCrossover(NewEmptyBinaryChromosome)).
+		Mutator(NewBinaryMutator(mutationProb)).
+		CostFunction(countOnes).
+		StopCriterion(NewStopCriterionDefault().Max_Generations(20)).
+		PopSize(10).
+		ChromSize(20)
+}
+func newTestIslandOptimizer(seed int64) *IslandOptimizer {
+	optimizer := NewIslandOptimizer().
+		MigrationInterval(5).
+		MigrantCount(2).
+		ReplacedSelector(NewSimpleTournamentSelector(2)).
+		MigrantConstructor(NewEmptyBinaryChromosome).
+		StopCriterion(NewStopCriterionDefault().Max_Generations(30)).
+		StatisticsOptions(NewStatisticsDefaultOptions().TrackMinCosts().TrackEvaluations()).
+		Seed(seed)
+
+	for i := 0; i < 3; i++ {
+		optimizer.Island(NewSimpleOptimizer().
+			Elitism(1).
+			CrossoverProbability(0.8).
+			Initializer(NewBinaryRandomInitializer()).
+			Selector(NewSimpleTournamentSelector(2)).
+			Crossover(NewTwoPointCrossover(NewEmptyBinaryChromosome)).
+			Mutator(NewBinaryMutator(0.05)).
+			CostFunction(countOnes).
+			StopCriterion(NewStopCriterionDefault().Max_Generations(100)).
+			StatisticsOptions(NewStatisticsDefaultOptions().TrackMinCosts().TrackEvaluations()).
+			PopSize(10).
+			ChromSize(20).
+			Seed(seed*10 + int64(i)))
+	}
+
+	return optimizer
+}
+
+func (s *OptimizerSuite) TestNSGA2Optimizer(c *C) {
+	// Ones in the first half are traded off, ones in the second half are worse in both objectives
+	cost := func(chrom ChromosomeInterface) MultiCost {
+		genes := chrom.(*BinaryChromosome).BinaryGenes()
+		half := len(genes) / 2
+
+		cost := MultiCost{0, float64(half)}
+		for i, gene := range genes {
+			if !gene {
+				continue
+			}
+
+			cost[0]++
+			if i < half {
+				cost[1]--
+			} else {
+				cost[1]++
+			}
+		}
+		return cost
+	}
+
+	optimizer := NewNSGA2Optimizer().MultiCostFunction(cost)
+	optimizer.
+		Initializer(NewBinaryRandomInitializer()).
+		Crossover(NewTwoPointCrossover(NewEmptyBinaryChromosome)).
+		Mutator(NewBinaryMutator(0.05).WithoutElitism()).
+		StopCriterion(NewStopCriterionDefault().Max_Generations(50)).
+		StatisticsOptions(NewStatisticsDefaultOptions().TrackEvaluations()).
+		PopSize(30).
+		ChromSize(10).
+		Seed(1)
+
+	front, data := optimizer.Optimize()
+
+	c.Assert(data.(StatisticsDataDefault).Evaluations(), Equals, 30+50*30)
+	c.Assert(len(front) > 1, Equals, true)
+
+	objectives := make(map[float64]bool)
+	for _, solution := range front {
+		c.Assert(solution.Cost, DeepEquals, cost(solution.Chromosome))
+		c.Assert(solution.Cost[0]+solution.Cost[1], Equals, 5.0)
+		objectives[solution.Cost[0]] = true
+
+		for _, other := range front {
+			c.Assert(other.Cost.Dominates(solution.Cost), Equals, false)
+		}
+	}
+	c.Assert(len(objectives) >= 5, Equals, true)
+}
+func (s *OptimizerSuite) TestNSGA2Optimizer_ReturnsConfigErrors(c *C) {
+	optimizer := NewNSGA2Optimizer()
+	optimizer.
+		Initializer(NewBinaryRandomInitializer()).
+		Crossover(NewTwoPointCrossover(NewEmptyBinaryChromosome)).
+		Mutator(NewBinaryMutator(0.05)).
+		StopCriterion(NewStopCriterionDefault().Max_Generations(5)).
+		PopSize(10).
+		ChromSize(10)
+
+	_, _, err := optimizer.OptimizeContext(context.Background())
+	c.Assert(err, FitsTypeOf, &ConfigError{})
+
+	optimizer.MultiCostFunction(func(chrom ChromosomeInterface) MultiCost {
+		if countOnes(chrom) > 5 {
+			return MultiCost{1}
+		}
+		return MultiCost{1, 2}
+	})
+	_, _, err = optimizer.OptimizeContext(context.Background())
+	c.Assert(err, FitsTypeOf, &ConfigError{})
+
+	optimizer.MultiCostFunction(func(chrom ChromosomeInterface) MultiCost {
+		return MultiCost{countOnes(chrom), -countOnes(chrom)}
+	})
+	_, _, err = optimizer.CrossoverProbability(0).OptimizeContext(context.Background())
+	c.Assert(err, ErrorMatches, "NSGA2Optimizer: CrossoverProbability .*")
+
+	_, _, err = optimizer.CrossoverProbability(0.5).OptimizeContext(context.Background())
+	c.Assert(err, IsNil)
+}
+
+func (s *OptimizerSuite) TestOptimizerBase_ConstrainedCost(c *C) {
+	// Maximize number of ones, but no more than 5 are allowed
+	cost := func(chrom ChromosomeInterface) ConstrainedCost {
+		ones := countOnes(chrom)
+		return ConstrainedCost{Objective: 20 - ones, Violation: math.Max(0, ones-5)}
+	}
+
+	handlers := map[ConstraintHandlerInterface]bool{
+		NewStaticPenalty(10):       true,
+		NewFeasibilityRules():      true,
+		NewAdaptivePenalty(1, 3):   false,
+		NewStochasticRanking(0.45): false,
+	}
+
+	for handler, bestIsFeasible := range handlers {
+		for _, optimizer := range []*OptimizerBase{
+			NewSimpleOptimizer().Elitism(1).CrossoverProbability(0.8).OptimizerBase,
+			NewSteadyStateOptimizer().OptimizerBase,
+		} {
+			best, data := optimizer.
+				Initializer(NewBinaryRandomInitializer()).
+				Selector(NewSimpleTournamentSelector(2)).
+				Crossover(NewTwoPointCrossover(NewEmptyBinaryChromosome)).
+				Mutator(NewBinaryMutator(0.05).WithoutElitism()).
+				ConstrainedCostFunction(cost).
+				ConstraintHandler(handler).
+				StopCriterion(NewStopCriterionDefault().Max_Generations(100)).
+				StatisticsOptions(NewStatisticsDefaultOptions().TrackFeasibility()).
+				PopSize(20).
+				ChromSize(20).
+				Seed(2).
+				Optimize()
+
+			stats := data.(StatisticsDataDefault)
+			c.Assert(stats.FeasibleFractions(), HasLen, 101)
+			c.Assert(stats.FeasibleFraction() > 0, Equals, true)
+			// Adaptive penalty and stochastic ranking may prefer slightly infeasible solutions
+			if bestIsFeasible {
+				c.Assert(cost(best), Equals, ConstrainedCost{Objective: 15})
+			}
+		}
+	}
+}
+func (s *OptimizerSuite) TestOptimizerBase_ConstrainedCost_TracksBestByConstrainedCost(c *C) {
+	cost := func(chrom ChromosomeInterface) ConstrainedCost {
+		ones := countOnes(chrom)
+		return ConstrainedCost{Objective: 20 - ones, Violation: math.Max(0, ones-5)}
+	}
+
+	var newBest []ConstrainedCost
+	best, _ := NewSimpleOptimizer().Elitism(1).CrossoverProbability(0.8).
+		Initializer(NewBinaryRandomInitializer()).
+		Selector(NewSimpleTournamentSelector(2)).
+		Crossover(NewTwoPointCrossover(NewEmptyBinaryChromosome)).
+		Mutator(NewBinaryMutator(0.05).WithoutElitism()).
+		ConstrainedCostFunction(cost).
+		ConstraintHandler(NewStochasticRanking(0.45)).
+		StopCriterion(NewStopCriterionDefault().Max_Generations(50)).
+		Observer(&newBestObserver{onNewBest: func(chrom ChromosomeInterface) {
+			newBest = append(newBest, cost(chrom))
+		}}).
+		PopSize(20).
+		ChromSize(20).
+		Seed(2).
+		Optimize()
+
+	// Costs set by stochastic ranking are positions, the best cost is always 0
+	c.Assert(len(newBest) > 1, Equals, true)
+	for i := 1; i < len(newBest); i++ {
+		c.Assert(newBest[i].Better(newBest[i-1]), Equals, true)
+	}
+	c.Assert(best, NotNil)
+}
+func (s *OptimizerSuite) TestOptimizerBase_ConstrainedCost_RejectsCostComparingStopCriteria(c *C) {
+	optimizer := func(handler ConstraintHandlerInterface, criterion StopCriterionInterface) *OptimizerBase {
+		return NewSimpleOptimizer().Elitism(1).CrossoverProbability(0.8).
+			Initializer(NewBinaryRandomInitializer()).
+			Selector(NewSimpleTournamentSelector(2)).
+			Crossover(NewTwoPointCrossover(NewEmptyBinaryChromosome)).
+			Mutator(NewBinaryMutator(0.05)).
+			ConstrainedCostFunction(func(chrom ChromosomeInterface) ConstrainedCost {
+				return ConstrainedCost{Objective: countOnes(chrom)}
+			}).
+			ConstraintHandler(handler).
+			StopCriterion(criterion).
+			PopSize(10).
+			ChromSize(10)
+	}
+
+	for _, handler := range []ConstraintHandlerInterface{NewAdaptivePenalty(1, 3), NewStochasticRanking(0.45)} {
+		for _, criterion := range []StopCriterionInterface{
+			NewStopCriterionDefault().Max_Generations(5).Min_Cost(0),
+			NewStopCriterionDefault().Max_Generations(5).Max_GenerationsWithoutImprovements(2),
+			AnyOf(NewStopCriterionDefault().Max_Generations(5), NewImprovementStopCriterion(2, 0.1)),
+		} {
+			_, _, err := optimizer(handler, criterion).OptimizeContext(context.Background())
+			c.Assert(err, FitsTypeOf, &ConfigError{})
+		}
+
+		_, _, err := optimizer(handler, NewStopCriterionDefault().Max_Generations(5)).OptimizeContext(context.Background())
+		c.Assert(err, IsNil)
+	}
+
+	for _, handler := range []ConstraintHandlerInterface{NewStaticPenalty(1), NewFeasibilityRules()} {
+		_, _, err := optimizer(handler, NewStopCriterionDefault().Max_Generations(5).Min_Cost(0)).OptimizeContext(context.Background())
+		c.Assert(err, IsNil)
+	}
+}
+func (s *OptimizerSuite) TestOptimizerBase_FeasibilityRules_MinCostIgnoresInfeasibleGenerations(c *C) {
+	// Costs of infeasible generation are violations, which are lower than the target cost
+	cost := func(chrom ChromosomeInterface) ConstrainedCost {
+		ones := countOnes(chrom)
+		return ConstrainedCost{Objective: ones, Violation: math.Max(0, 15-ones)}
+	}
+
+	best, data := NewSimpleOptimizer().Elitism(1).CrossoverProbability(0.8).
+		Initializer(NewBinaryRandomInitializer()).
+		Selector(NewSimpleTournamentSelector(2)).
+		Crossover(NewTwoPointCrossover(NewEmptyBinaryChromosome)).
+		Mutator(NewBinaryMutator(0.05)).
+		ConstrainedCostFunction(cost).
+		StopCriterion(NewStopCriterionDefault().Max_Generations(20).Min_Cost(4)).
+		PopSize(20).
+		ChromSize(20).
+		Seed(1).
+		Optimize()
+
+	c.Assert(data.(StatisticsDataDefault).Generations(), Equals, 20)
+	c.Assert(countOnes(best) >= 15, Equals, true)
+}
+func (s *OptimizerSuite) TestOptimizerBase_ConstrainedCost_ReturnsConfigErrors(c *C) {
+	_, _, err := NewSimpleOptimizer().Elitism(1).CrossoverProbability(0.8).
+		Initializer(NewBinaryRandomInitializer()).
+		Selector(NewSimpleTournamentSelector(2)).
+		Crossover(NewTwoPointCrossover(NewEmptyBinaryChromosome)).
+		Mutator(NewBinaryMutator(0.05)).
+		CostFunction(countOnes).
+		ConstrainedCostFunction(func(ChromosomeInterface) ConstrainedCost { return ConstrainedCost{} }).
+		StopCriterion(NewStopCriterionDefault().Max_Generations(5)).
+		PopSize(10).
+		ChromSize(10).
+		OptimizeContext(context.Background())
+
+	c.Assert(err, FitsTypeOf, &ConfigError{})
+}
+
+type populationObserver struct {
+	ObserverBase
+
+	onGeneration func(Chromosomes)
+}
+
+func (observer *populationObserver) OnGeneration(generation int, population Chromosomes, statistics StatisticsDataInterface) {
+	observer.onGeneration(population)
+}
+
+type recordingObserver struct {
+	ObserverBase
+
+	events      []string
+	generations []int
+	bestCosts   []float64
+	best        ChromosomeInterface
+	err         error
+}
+
+func (observer *recordingObserver) OnStart() {
+	observer.events = append(observer.events, "start")
+}
+func (observer *recordingObserver) OnGeneration(generation int, population Chromosomes, statistics StatisticsDataInterface) {
+	observer.events = append(observer.events, "generation")
+	observer.generations = append(observer.generations, generation)
+}
+func (observer *recordingObserver) OnNewBest(generation int, best ChromosomeInterface) {
+	observer.bestCosts = append(observer.bestCosts, best.Cost())
+}
+func (observer *recordingObserver) OnEnd(best ChromosomeInterface, statistics StatisticsDataInterface, err error) {
+	observer.events = append(observer.events, "end")
+	observer.best = best
+	observer.err = err
+}
+
+type newBestObserver struct {
+	ObserverBase
+
+	onNewBest func(ChromosomeInterface)
+}
+
+func (observer *newBestObserver) OnNewBest(generation int, best ChromosomeInterface) {
+	observer.onNewBest(best)
+}
+
+type stopCriterionFunc func(StatisticsDataInterface) bool
+
+func (criterion stopCriterionFunc) Setup(StatisticsOptionsInterface) {}
+func (criterion stopCriterionFunc) ShouldStop(data StatisticsDataInterface) bool {
+	return criterion(data)
+}
+
+func countOnes(chrom ChromosomeInterface) float64 {
+	ones := 0
+	for _, g := range chrom.(*BinaryChromosome).BinaryGenes() {
+		if g {
+			ones++
+		}
+	}
+	return float64(ones)
+}
+func displacement(chrom ChromosomeInterface) float64 {
+	sum := 0
+	for i, g := range chrom.(*OrderedChromosome).OrderedGenes() {
+		if g > i {
+			sum += g - i
+		} else {
+			sum += i - g
+		}
+	}
+	return float64(sum)
+}
+func sumOfSquares(chrom ChromosomeInterface) float64 {
+	sum := 0.0
+	for _, g := range chrom.(*RealChromosome).RealGenes() {
+		sum += g * g
+	}
+	return sum
+}
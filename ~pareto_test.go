@@ -0,0 +1,45 @@
+package genetic_algorithm
+
+import (
+	. "gopkg.in/check.v1"
+	"math"
+)
+
+type ParetoSuite struct{}
+
+var _ = Suite(&ParetoSuite{})
+
+func (s *ParetoSuite) TestMultiCost_Dominates(c *C) {
+	c.Assert(MultiCost{1, 2}.Dominates(MultiCost{1, 3}), Equals, true)
+	c.Assert(MultiCost{1, 2}.Dominates(MultiCost{2, 3}), Equals, true)
+	c.Assert(MultiCost{1, 2}.Dominates(MultiCost{1, 2}), Equals, false)
+	c.Assert(MultiCost{1, 2}.Dominates(MultiCost{2, 1}), Equals, false)
+	c.Assert(MultiCost{1, 3}.Dominates(MultiCost{1, 2}), Equals, false)
+}
+func (s *ParetoSuite) TestNonDominatedSort(c *C) {
+	costs := []MultiCost{
+		{3, 3},
+		{1, 4},
+		{2, 2},
+		{4, 1},
+		{4, 4},
+		{3, 2},
+	}
+
+	c.Assert(nonDominatedSort(costs), DeepEquals, [][]int{{1, 2, 3}, {5}, {0}, {4}})
+}
+func (s *ParetoSuite) TestCrowdingDistances(c *C) {
+	costs := []MultiCost{
+		{1, 4},
+		{4, 1},
+		{2, 3},
+		{3, 1.5},
+	}
+
+	distances := crowdingDistances(costs, []int{0, 1, 2, 3})
+
+	c.Assert(math.IsInf(distances[0], 1), Equals, true)
+	c.Assert(math.IsInf(distances[1], 1), Equals, true)
+	c.Assert(distances[2], Equals, (3-1)/3.0+(4-1.5)/3.0)
+	c.Assert(distances[3], Equals, (4-2)/3.0+(3-1)/3.0)
+}
@@ -0,0 +1,89 @@
+package genetic_algorithm
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type RepairSuite struct{}
+
+var _ = Suite(&RepairSuite{})
+
+func (s *RepairSuite) TestPermutationRepair(c *C) {
+	chrom := NewOrderedChromosome(OrderedGenes{3, -1, 3, 0, 7, -1})
+	chrom.SetCost(1)
+
+	NewPermutationRepair().Repair(chrom)
+
+	c.Assert(chrom.OrderedGenes(), DeepEquals, OrderedGenes{3, 1, 2, 0, 4, 5})
+	c.Assert(chrom.Dirty(), Equals, true)
+}
+func (s *RepairSuite) TestPermutationRepair_KeepsValidPermutation(c *C) {
+	chrom := NewOrderedChromosome(OrderedGenes{2, 0, 1})
+	chrom.SetCost(1)
+
+	NewPermutationRepair().Repair(chrom)
+
+	c.Assert(chrom.OrderedGenes(), DeepEquals, OrderedGenes{2, 0, 1})
+	c.Assert(chrom.Dirty(), Equals, false)
+}
+func (s *RepairSuite) TestPrecedenceRepair(c *C) {
+	// 0 after 3, 2 after 0 and 1
+	repair := NewPrecedenceRepair([][]int{{3}, {}, {0, 1}, {}, {}})
+	c.Assert(repair.Check(), IsNil)
+
+	chrom := NewOrderedChromosome(OrderedGenes{2, 4, 0, 1, 3})
+	chrom.SetCost(1)
+
+	repair.Repair(chrom)
+
+	c.Assert(chrom.OrderedGenes(), DeepEquals, OrderedGenes{4, 1, 3, 0, 2})
+	c.Assert(chrom.Dirty(), Equals, true)
+
+	chrom = NewOrderedChromosome(OrderedGenes{-1, 3, 3, 0, 1})
+	repair.Repair(chrom)
+	c.Assert(chrom.OrderedGenes(), DeepEquals, OrderedGenes{3, 4, 0, 1, 2})
+}
+func (s *RepairSuite) TestPrecedenceRepair_Check(c *C) {
+	c.Assert(NewPrecedenceRepair([][]int{{1}, {2}, {0}}).Check(), NotNil)
+	c.Assert(NewPrecedenceRepair([][]int{{0}}).Check(), NotNil)
+	c.Assert(NewPrecedenceRepair([][]int{{5}}).Check(), NotNil)
+}
+func (s *RepairSuite) TestOptimizerBase_RepairsChildren(c *C) {
+	predecessors := [][]int{{}, {}, {1}, {}, {2, 0}, {}, {}, {3}}
+	valid := true
+	cost := func(chrom ChromosomeInterface) float64 {
+		genes := chrom.(*OrderedChromosome).OrderedGenes()
+		for gene, genePredecessors := range predecessors {
+			for _, predecessor := range genePredecessors {
+				valid = valid && genes.Ind(predecessor) != -1 && genes.Ind(predecessor) < genes.Ind(gene)
+			}
+		}
+		return displacement(chrom)
+	}
+
+	NewSimpleOptimizer().Elitism(1).CrossoverProbability(1).
+		Initializer(NewOrderedRandomInitializer()).
+		Selector(NewSimpleTournamentSelector(2)).
+		Crossover(emptyChildrenCrossover{}).
+		Mutator(NewSwapMutator(0.5)).
+		Repair(NewPrecedenceRepair(predecessors)).
+		CostFunction(cost).
+		StopCriterion(NewStopCriterionDefault().Max_Generations(10)).
+		PopSize(10).
+		ChromSize(len(predecessors)).
+		Seed(1).
+		Optimize()
+
+	c.Assert(valid, Equals, true)
+}
+
+// Crossover which leaves children unfilled
+type emptyChildrenCrossover struct{}
+
+func (crossover emptyChildrenCrossover) ParentsCount() int {
+	return 2
+}
+func (crossover emptyChildrenCrossover) Crossover(parents Chromosomes) Chromosomes {
+	genesLen := parents[0].Genes().Len()
+	return Chromosomes{NewEmptyOrderedChromosome(genesLen), NewEmptyOrderedChromosome(genesLen)}
+}
@@ -0,0 +1,72 @@
+package genetic_algorithm
+
+import (
+	. "gopkg.in/check.v1"
+	"sort"
+)
+
+type ReplacementSuite struct{}
+
+var _ = Suite(&ReplacementSuite{})
+
+func (s *ReplacementSuite) TestReplaceWorst(c *C) {
+	population := chromosomesWithCosts(1, 2, 3, 4)
+	child1 := chromosomesWithCosts(2.5)[0]
+	child2 := chromosomesWithCosts(5)[0]
+
+	NewReplaceWorst().Replace(population, []Family{{nil, Chromosomes{child1, child2}}})
+
+	c.Assert(costsOf(population), DeepEquals, []float64{1, 2, 2.5, 5})
+	c.Assert(population[2], Equals, child1)
+}
+func (s *ReplacementSuite) TestReverseTournamentReplacement_KeepsElite(c *C) {
+	replacement := NewReverseTournamentReplacement(2).WithElitism(2)
+
+	for i := 0; i < 20; i++ {
+		population := chromosomesWithCosts(1, 2, 3, 4, 5)
+		best := Chromosomes{population[0]}
+		children := chromosomesWithCosts(0, 10)
+
+		replacement.Replace(population, []Family{{nil, children}})
+
+		c.Assert(sort.IsSorted(population), Equals, true)
+		// Elite is recomputed after each child
+		c.Assert(population[0], Equals, children[0])
+		c.Assert(population[1], Equals, best[0])
+	}
+}
+func (s *ReplacementSuite) TestReplaceParentIfBetter(c *C) {
+	population := chromosomesWithCosts(1, 2, 3, 4)
+	parents := Chromosomes{population[1], population[3]}
+	children := chromosomesWithCosts(3.5, 0.5)
+
+	NewReplaceParentIfBetter().Replace(population, []Family{{parents, children}})
+
+	// 3.5 replaces parent 4, 0.5 replaces parent 2
+	c.Assert(costsOf(population), DeepEquals, []float64{0.5, 1, 3, 3.5})
+
+	population = chromosomesWithCosts(1, 2, 3, 4)
+	parents = Chromosomes{population[0], population[1]}
+	children = chromosomesWithCosts(2, 5)
+
+	NewReplaceParentIfBetter().Replace(population, []Family{{parents, children}})
+
+	c.Assert(costsOf(population), DeepEquals, []float64{1, 2, 3, 4})
+	c.Assert(population[1], Equals, parents[1])
+}
+
+func chromosomesWithCosts(costs ...float64) Chromosomes {
+	chroms := make(Chromosomes, len(costs))
+	for i, cost := range costs {
+		chroms[i] = NewBinaryChromosome(BinaryGenes{})
+		chroms[i].SetCost(cost)
+	}
+	return chroms
+}
+func costsOf(chroms Chromosomes) []float64 {
+	costs := make([]float64, len(chroms))
+	for i, chrom := range chroms {
+		costs[i] = chrom.Cost()
+	}
+	return costs
+}
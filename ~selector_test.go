@@ -3,6 +3,7 @@ package genetic_algorithm
 import (
 	"code.google.com/p/gomock/gomock"
 	. "gopkg.in/check.v1"
+	"sort"
 )
 
 type SelectorSuite struct{}
@@ -68,3 +69,136 @@ func (s *SelectorSuite) Test_SelectorWheelRank_Weighting(c *C) {
 	selector.Prepare(make(Chromosomes, 4))
 	c.Assert(selector.weights, DeepEquals, []float64{0.4, 0.3, 0.2, 0.1})
 }
+
+func (s *SelectorSuite) Test_StochasticUniversalSampling_EvenlySpacedPointers(c *C) {
+	pop := make(Chromosomes, 4)
+	for i := range pop {
+		pop[i] = NewEmptyBinaryChromosome(1)
+		pop[i].SetCost(0)
+	}
+
+	selector := NewStochasticUniversalSamplingSelector()
+	selector.SelectManyAreUnique(false)
+	selector.Prepare(pop)
+
+	inds := selector.selectManyInd(4)
+	sort.Ints(inds)
+	c.Assert(inds, DeepEquals, []int{0, 1, 2, 3})
+}
+func (s *SelectorSuite) Test_StochasticUniversalSampling_SelectManyAreUnique(c *C) {
+	pop := make(Chromosomes, 4)
+	for i := range pop {
+		pop[i] = NewEmptyBinaryChromosome(1)
+		pop[i].SetCost(1e6)
+	}
+	pop[0].SetCost(0)
+
+	selector := NewStochasticUniversalSamplingSelector()
+	selector.Prepare(pop)
+
+	selected := selector.SelectMany(3)
+	c.Assert(selected[0] != selected[1] && selected[1] != selected[2] && selected[0] != selected[2], Equals, true)
+}
+func (s *SelectorSuite) Test_TruncationSelector_SelectsFromTheBest(c *C) {
+	pop := make(Chromosomes, 4)
+
+	selector := NewTruncationSelector(0.5)
+	selector.Prepare(pop)
+
+	for i := 0; i < 100; i++ {
+		c.Assert(selector.SelectInd() < 2, Equals, true)
+	}
+
+	c.Assert(NewTruncationSelector(0).Check(), NotNil)
+	c.Assert(NewTruncationSelector(1).Check(), IsNil)
+}
+func (s *SelectorSuite) Test_TruncationSelector_SelectManyUniqueFromTheBest(c *C) {
+	pop := make(Chromosomes, 8)
+	for i := range pop {
+		pop[i] = NewEmptyBinaryChromosome(1)
+	}
+	best := map[ChromosomeInterface]bool{pop[0]: true, pop[1]: true, pop[2]: true, pop[3]: true}
+
+	selector := NewTruncationSelector(0.5)
+	selector.Prepare(pop)
+
+	for i := 0; i < 100; i++ {
+		selected := selector.SelectMany(4)
+		unique := make(map[ChromosomeInterface]bool)
+		for _, chrom := range selected {
+			c.Assert(best[chrom], Equals, true)
+			unique[chrom] = true
+		}
+		c.Assert(unique, HasLen, 4)
+	}
+
+	c.Assert(func() { selector.SelectMany(5) }, PanicMatches, `.*unique.*`)
+
+	selector.SelectManyAreUnique(false)
+	c.Assert(selector.SelectMany(5), HasLen, 5)
+}
+func (s *SelectorSuite) Test_StochasticUniversalSampling_HitsProportionalToFitness(c *C) {
+	pop := make(Chromosomes, 3)
+	for i, cost := range []float64{0, 1, 3} {
+		pop[i] = NewEmptyBinaryChromosome(1)
+		pop[i].SetCost(cost)
+	}
+
+	selector := NewStochasticUniversalSamplingSelector()
+	selector.SelectManyAreUnique(false)
+	selector.Prepare(pop)
+
+	// Fitnesses are 1, 1/2 and 1/4, so 7 evenly spaced pointers hit 4, 2 and 1 times
+	for i := 0; i < 100; i++ {
+		hits := make(map[ChromosomeInterface]int)
+		for _, chrom := range selector.SelectMany(7) {
+			hits[chrom]++
+		}
+		c.Assert(hits[pop[0]], Equals, 4)
+		c.Assert(hits[pop[1]], Equals, 2)
+		c.Assert(hits[pop[2]], Equals, 1)
+	}
+}
+func (s *SelectorSuite) Test_StochasticUniversalSampling_NegativeCostIsConfigError(c *C) {
+	pop := Chromosomes{NewEmptyBinaryChromosome(1)}
+	pop[0].SetCost(-1)
+
+	selector := NewStochasticUniversalSamplingSelector()
+
+	c.Assert(func() { selector.Prepare(pop) }, PanicMatches, `StochasticUniversalSamplingSelector: .*negative cost.*`)
+}
+func (s *SelectorSuite) Test_LinearRankingSelector_Probabilities(c *C) {
+	selector := NewLinearRankingSelector(2)
+	c.Assert(selector.probability(0, 3), Within, 1e-9, 2/3.0)
+	c.Assert(selector.probability(1, 3), Within, 1e-9, 1/3.0)
+	c.Assert(selector.probability(2, 3), Within, 1e-9, 0.0)
+
+	selector = NewLinearRankingSelector(1)
+	c.Assert(selector.probability(0, 4), Within, 1e-9, 0.25)
+	c.Assert(selector.probability(3, 4), Within, 1e-9, 0.25)
+
+	c.Assert(NewLinearRankingSelector(2.5).Check(), NotNil)
+	c.Assert(NewLinearRankingSelector(1.5).Check(), IsNil)
+}
+func (s *SelectorSuite) Test_LinearRankingSelector_NeverSelectsWorstWithMaxPressure(c *C) {
+	pop := make(Chromosomes, 3)
+
+	selector := NewLinearRankingSelector(2)
+	selector.Prepare(pop)
+
+	for i := 0; i < 100; i++ {
+		c.Assert(selector.SelectInd() < 2, Equals, true)
+	}
+}
+func (s *SelectorSuite) Test_ExponentialRankingSelector_Probabilities(c *C) {
+	selector := NewExponentialRankingSelector(0.5)
+
+	sum := 0.0
+	for i := 0; i < 4; i++ {
+		sum += selector.probability(i, 4)
+	}
+	c.Assert(sum, Within, 1e-9, 1.0)
+	c.Assert(selector.probability(1, 4)/selector.probability(0, 4), Within, 1e-9, 0.5)
+
+	c.Assert(NewExponentialRankingSelector(1).Check(), NotNil)
+}
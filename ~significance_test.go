@@ -0,0 +1,48 @@
+package genetic_algorithm
+
+import (
+	. "gopkg.in/check.v1"
+	"math"
+)
+
+type SignificanceSuite struct{}
+
+var _ = Suite(&SignificanceSuite{})
+
+func (s *SignificanceSuite) Test_AverageRanks(c *C) {
+	ranks, ties := averageRanks([]float64{3, 1, 3, 2})
+
+	c.Assert(ranks, DeepEquals, []float64{3.5, 1, 3.5, 2})
+	c.Assert(ties, Equals, 6.0)
+}
+func (s *SignificanceSuite) TestMannWhitneyU(c *C) {
+	u, p := MannWhitneyU([]float64{1, 2, 3}, []float64{4, 5, 6})
+
+	c.Assert(u, Equals, 0.0)
+	c.Assert(p, Within, 1e-9, math.Erfc(4/math.Sqrt(5.25)/math.Sqrt2))
+
+	u, p = MannWhitneyU([]float64{4, 5, 6}, []float64{1, 2, 3})
+	c.Assert(u, Equals, 9.0)
+	c.Assert(p, Within, 1e-9, math.Erfc(4/math.Sqrt(5.25)/math.Sqrt2))
+
+	_, p = MannWhitneyU([]float64{1, 1}, []float64{1, 1})
+	c.Assert(p, Equals, 1.0)
+}
+func (s *SignificanceSuite) TestWilcoxonSignedRank(c *C) {
+	w, p := WilcoxonSignedRank([]float64{1, 2, 3, 4}, []float64{0, 0, 0, 0})
+
+	c.Assert(w, Equals, 10.0)
+	c.Assert(p, Within, 1e-9, math.Erfc(4.5/math.Sqrt(7.5)/math.Sqrt2))
+
+	w, p = WilcoxonSignedRank([]float64{1, 2}, []float64{1, 2})
+	c.Assert(w, Equals, 0.0)
+	c.Assert(p, Equals, 1.0)
+}
+func (s *SignificanceSuite) TestWilcoxonSignedRank_PanicsOnDifferentSizes(c *C) {
+	c.Assert(func() { WilcoxonSignedRank([]float64{1}, []float64{1, 2}) }, PanicMatches, ".*equal size")
+}
+func (s *SignificanceSuite) TestVarghaDelaneyA(c *C) {
+	c.Assert(VarghaDelaneyA([]float64{3, 4}, []float64{1, 2}), Equals, 1.0)
+	c.Assert(VarghaDelaneyA([]float64{1, 2}, []float64{2, 3}), Equals, 0.125)
+	c.Assert(VarghaDelaneyA([]float64{1, 2}, []float64{1, 2}), Equals, 0.5)
+}
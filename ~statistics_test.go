@@ -1,7 +1,10 @@
 package genetic_algorithm
 
 import (
+	"bytes"
 	. "gopkg.in/check.v1"
+	"math"
+	"strings"
 	"time"
 )
 
@@ -34,3 +37,277 @@ func (s *StatisticsSuite) TestStatisticsDefault_StopTrackTime_After_Stop(c *C) {
 
 	c.Assert(d2 == d1, Equals, true)
 }
+func (s *StatisticsSuite) TestStatisticsDefault_Diversity(c *C) {
+	stat := NewStatisticsDefault(NewStatisticsDefaultOptions().
+		TrackGenotypicDiversity().
+		TrackLocusEntropy().
+		TrackCostStdDev().
+		TrackUniqueGenotypes()).(*StatisticsDefault)
+
+	population := Chromosomes{
+		NewBinaryChromosome(BinaryGenes{true, true, false}),
+		NewBinaryChromosome(BinaryGenes{true, true, false}),
+		NewBinaryChromosome(BinaryGenes{true, false, false}),
+		NewBinaryChromosome(BinaryGenes{true, false, true}),
+	}
+	for i, chrom := range population {
+		chrom.SetCost(float64(i))
+	}
+	stat.OnGeneration(population)
+
+	// Distances: 0, 1, 2, 1, 2, 1
+	c.Assert(stat.GenotypicDiversity(), Equals, 7/6.0)
+	c.Assert(stat.LocusEntropy(), DeepEquals, []float64{0, 1, -0.75*math.Log2(0.75) - 0.25*math.Log2(0.25)})
+	c.Assert(stat.CostStdDev(), Equals, math.Sqrt(1.25))
+	c.Assert(stat.UniqueGenotypes(), Equals, 3)
+
+	for i := range population {
+		population[i] = population[0]
+	}
+	stat.OnGeneration(population)
+
+	c.Assert(stat.GenotypicDiversities(), DeepEquals, []float64{7 / 6.0, 0})
+	c.Assert(stat.MeanLocusEntropies()[1], Equals, 0.0)
+	c.Assert(stat.CostStdDevs()[1], Equals, 0.0)
+	c.Assert(stat.UniqueGenotypesCounts(), DeepEquals, []int{3, 1})
+}
+func (s *StatisticsSuite) TestStatisticsDefault_Diversity_OrderedGenes(c *C) {
+	stat := NewStatisticsDefault(NewStatisticsDefaultOptions().TrackGenotypicDiversity()).(*StatisticsDefault)
+
+	population := Chromosomes{
+		NewOrderedChromosome(OrderedGenes{0, 1, 2}),
+		NewOrderedChromosome(OrderedGenes{2, 1, 0}),
+	}
+	stat.OnGeneration(population)
+
+	c.Assert(stat.GenotypicDiversity(), Equals, 3.0)
+}
+func (s *StatisticsSuite) TestStatisticsDefault_Diversity_RealAndIntegerGenes(c *C) {
+	stat := NewStatisticsDefault(NewStatisticsDefaultOptions().TrackGenotypicDiversity()).(*StatisticsDefault)
+
+	bounds := NewUniformRealBounds(-10, 10)
+	stat.OnGeneration(Chromosomes{
+		NewRealChromosome(RealGenes{0, 0}, bounds),
+		NewRealChromosome(RealGenes{3, 4}, bounds),
+	})
+	c.Assert(stat.GenotypicDiversity(), Equals, 5.0)
+
+	integerBounds := NewUniformIntegerBounds(-10, 10)
+	stat.OnGeneration(Chromosomes{
+		NewIntegerChromosome(IntegerGenes{0, 0}, integerBounds),
+		NewIntegerChromosome(IntegerGenes{3, -4}, integerBounds),
+	})
+	c.Assert(stat.GenotypicDiversity(), Equals, 7.0)
+}
+
+func (s *StatisticsSuite) newExportedStatistics() *StatisticsDefault {
+	stat := NewStatisticsDefault(NewStatisticsDefaultOptions().
+		TrackMinCosts().
+		TrackMeanCosts().
+		TrackMinCostsVar().
+		TrackEvaluations().
+		TrackUniqueGenotypes()).(*StatisticsDefault)
+
+	stat.Start()
+	population := Chromosomes{
+		NewBinaryChromosome(BinaryGenes{true, false}),
+		NewBinaryChromosome(BinaryGenes{false, false}),
+	}
+	for i, chrom := range population {
+		chrom.SetCost(float64(i + 1))
+	}
+	stat.OnGeneration(population)
+	stat.OnEvaluations(2, 1)
+
+	population[0].SetCost(0.5)
+	stat.OnGeneration(population)
+	stat.End()
+
+	return stat
+}
+func (s *StatisticsSuite) TestStatisticsExport_JSON_RoundTrip(c *C) {
+	stat := s.newExportedStatistics()
+
+	buf := new(bytes.Buffer)
+	c.Assert(EncodeStatisticsJSON(buf, stat), IsNil)
+
+	decoded, err := DecodeStatisticsJSON(buf)
+	c.Assert(err, IsNil)
+
+	c.Assert(decoded.Generations(), Equals, stat.Generations())
+	c.Assert(decoded.Duration(), Equals, stat.Duration())
+	c.Assert(decoded.Durations(), IsNil)
+	c.Assert(decoded.MinCost(), Equals, 0.5)
+	c.Assert(decoded.MinCosts(), DeepEquals, []float64{1, 0.5})
+	c.Assert(decoded.MeanCosts(), DeepEquals, stat.MeanCosts())
+	c.Assert(decoded.WorstCosts(), IsNil)
+	c.Assert(decoded.Evaluations(), Equals, 2)
+	c.Assert(decoded.SkippedEvaluations(), Equals, 1)
+	c.Assert(decoded.UniqueGenotypesCounts(), DeepEquals, []int{2, 2})
+	c.Assert(decoded.MinCostsVar(), Equals, stat.MinCostsVar())
+}
+func (s *StatisticsSuite) TestStatisticsExport_JSON_KeepsNaN(c *C) {
+	stat := NewStatisticsDefault(NewStatisticsDefaultOptions().TrackGenotypicDiversity()).(*StatisticsDefault)
+	stat.OnGeneration(Chromosomes{NewOrderedChromosome(OrderedGenes{0, 1})})
+
+	buf := new(bytes.Buffer)
+	c.Assert(EncodeStatisticsJSON(buf, stat), IsNil)
+
+	decoded, err := DecodeStatisticsJSON(buf)
+	c.Assert(err, IsNil)
+	c.Assert(math.IsNaN(decoded.GenotypicDiversity()), Equals, math.IsNaN(stat.GenotypicDiversity()))
+	c.Assert(math.IsNaN(decoded.FeasibleFraction()), Equals, math.IsNaN(stat.FeasibleFraction()))
+}
+func (s *StatisticsSuite) TestStatisticsExport_CSV(c *C) {
+	stat := s.newExportedStatistics()
+
+	buf := new(bytes.Buffer)
+	c.Assert(EncodeStatisticsCSV(buf, stat), IsNil)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	c.Assert(lines, DeepEquals, []string{
+		"generation,min_cost,mean_cost,unique_genotypes",
+		"0,1,1.5,2",
+		"1,0.5,1.25,2",
+	})
+}
+func (s *StatisticsSuite) TestStatisticsExport_RequiresStatisticsDataDefault(c *C) {
+	c.Assert(EncodeStatisticsJSON(new(bytes.Buffer), nil), NotNil)
+	c.Assert(EncodeStatisticsCSV(new(bytes.Buffer), nil), NotNil)
+}
+
+func (s *StatisticsSuite) TestStatisticsDefaultAggregator_Distributions(c *C) {
+	options := NewStatisticsDefaultOptions().TrackMinCosts()
+	aggregator := NewStatisticsDefaultAggregator(options)
+
+	for _, minCosts := range [][]float64{{4, 3, 2}, {6, 1}, {5, 4, 3}, {7, 5, 4}} {
+		stat := NewStatisticsDefault(options).(*StatisticsDefault)
+		for _, cost := range minCosts {
+			chrom := NewBinaryChromosome(BinaryGenes{true})
+			chrom.SetCost(cost)
+			stat.OnGeneration(Chromosomes{chrom})
+		}
+		aggregator.Aggregate(stat)
+	}
+	data := aggregator.Compute().(StatisticsDataAggregated)
+
+	c.Assert(data.Runs(), Equals, 4)
+	c.Assert(data.Generations(), Equals, 2)
+	c.Assert(data.RunsAt(0), Equals, 4)
+	c.Assert(data.RunsAt(1), Equals, 4)
+	c.Assert(data.RunsAt(2), Equals, 3)
+	c.Assert(data.RunsAt(3), Equals, 0)
+	// Second run doesn't take part in distributions of the last generation
+	c.Assert(data.MinCostsMedians(), DeepEquals, []float64{5.5, 3.5, 3})
+
+	lower, upper := data.MinCostsQuartiles()
+	c.Assert(lower, DeepEquals, []float64{4.75, 2.5, 2.5})
+	c.Assert(upper, DeepEquals, []float64{6.25, 4.25, 3.5})
+
+	lower, upper = data.MinCostsEnvelope()
+	c.Assert(lower, DeepEquals, []float64{4, 1, 2})
+	c.Assert(upper, DeepEquals, []float64{7, 5, 4})
+
+	// Intervals are centered on means of runs which reached the generation
+	lower, upper = data.MinCostsConfidenceIntervals()
+	for i, mean := range []float64{5.5, 3.25, 3} {
+		c.Assert(lower[i] < mean, Equals, true)
+		c.Assert(upper[i]-mean, Within, 1e-9, mean-lower[i])
+	}
+
+	c.Assert(data.FinalMinCosts(), DeepEquals, []float64{1, 2, 3, 4})
+	c.Assert(data.FinalMinCostQuantile(0.5), Equals, 2.5)
+	c.Assert(data.SuccessRate(2), Equals, 0.5)
+	c.Assert(data.SuccessRate(0), Equals, 0.0)
+}
+func (s *StatisticsSuite) TestStatisticsExport_JSON_Aggregated(c *C) {
+	options := NewStatisticsDefaultOptions().TrackMinCosts().TrackEvaluationsCounts()
+	aggregator := NewStatisticsDefaultAggregator(options)
+
+	for _, minCosts := range [][]float64{{4, 3, 2}, {6, 1}, {5, 4, 3}} {
+		stat := NewStatisticsDefault(options).(*StatisticsDefault)
+		for _, cost := range minCosts {
+			chrom := NewBinaryChromosome(BinaryGenes{true})
+			chrom.SetCost(cost)
+			stat.OnEvaluations(1, 0)
+			stat.OnGeneration(Chromosomes{chrom})
+		}
+		aggregator.Aggregate(stat)
+	}
+	data := aggregator.Compute().(StatisticsDataAggregated)
+
+	buf := new(bytes.Buffer)
+	c.Assert(EncodeStatisticsJSON(buf, data), IsNil)
+
+	decoded, err := DecodeStatisticsJSON(buf)
+	c.Assert(err, IsNil)
+	aggregated, ok := decoded.(StatisticsDataAggregated)
+	c.Assert(ok, Equals, true)
+
+	c.Assert(aggregated.Runs(), Equals, 3)
+	c.Assert(aggregated.RunsAt(2), Equals, data.RunsAt(2))
+	c.Assert(aggregated.MinCosts(), DeepEquals, data.MinCosts())
+	c.Assert(aggregated.MinCostsMedians(), DeepEquals, data.MinCostsMedians())
+
+	lower, upper := aggregated.MinCostsQuartiles()
+	expectedLower, expectedUpper := data.MinCostsQuartiles()
+	c.Assert(lower, DeepEquals, expectedLower)
+	c.Assert(upper, DeepEquals, expectedUpper)
+
+	lower, upper = aggregated.MinCostsEnvelope()
+	expectedLower, expectedUpper = data.MinCostsEnvelope()
+	c.Assert(lower, DeepEquals, expectedLower)
+	c.Assert(upper, DeepEquals, expectedUpper)
+
+	lower, upper = aggregated.MinCostsConfidenceIntervals()
+	expectedLower, expectedUpper = data.MinCostsConfidenceIntervals()
+	c.Assert(lower, DeepEquals, expectedLower)
+	c.Assert(upper, DeepEquals, expectedUpper)
+
+	c.Assert(aggregated.FinalMinCosts(), DeepEquals, data.FinalMinCosts())
+	c.Assert(aggregated.FinalMinCostQuantile(0.5), Equals, data.FinalMinCostQuantile(0.5))
+	c.Assert(aggregated.SuccessRate(2), Equals, data.SuccessRate(2))
+	c.Assert(aggregated.MeanEvaluationsToTarget(3), Equals, data.MeanEvaluationsToTarget(3))
+
+	runs := aggregated.RunsStatistics()
+	c.Assert(runs, HasLen, 3)
+	c.Assert(runs[1].MinCosts(), DeepEquals, []float64{6, 1})
+}
+func (s *StatisticsSuite) TestStatisticsDefaultAggregator_RoundsUniqueGenotypes(c *C) {
+	options := NewStatisticsDefaultOptions().TrackUniqueGenotypes()
+	aggregator := NewStatisticsDefaultAggregator(options)
+
+	for _, population := range []Chromosomes{
+		{NewOrderedChromosome(OrderedGenes{0, 1, 2}), NewOrderedChromosome(OrderedGenes{2, 1, 0}), NewOrderedChromosome(OrderedGenes{1, 0, 2})},
+		{NewOrderedChromosome(OrderedGenes{0, 1, 2}), NewOrderedChromosome(OrderedGenes{2, 1, 0}), NewOrderedChromosome(OrderedGenes{2, 1, 0})},
+	} {
+		stat := NewStatisticsDefault(options).(*StatisticsDefault)
+		stat.OnGeneration(population)
+		aggregator.Aggregate(stat)
+	}
+	data := aggregator.Compute().(StatisticsDataAggregated)
+
+	c.Assert(data.UniqueGenotypes(), Equals, 3)
+	c.Assert(data.UniqueGenotypesCounts(), DeepEquals, []int{3})
+}
+func (s *StatisticsSuite) TestStatisticsDefault_EvaluationsToTarget(c *C) {
+	stat := NewStatisticsDefault(NewStatisticsDefaultOptions().
+		TrackMinCosts().
+		TrackEvaluationsCounts()).(*StatisticsDefault)
+
+	for _, cost := range []float64{5, 3, 1, 2} {
+		chrom := NewBinaryChromosome(BinaryGenes{true})
+		chrom.SetCost(cost)
+		stat.OnEvaluations(10, 0)
+		stat.OnGeneration(Chromosomes{chrom})
+	}
+
+	c.Assert(stat.EvaluationsCounts(), DeepEquals, []int{10, 20, 30, 40})
+
+	evaluations, ok := stat.EvaluationsToTarget(3)
+	c.Assert(ok, Equals, true)
+	c.Assert(evaluations, Equals, 20)
+
+	_, ok = stat.EvaluationsToTarget(0)
+	c.Assert(ok, Equals, false)
+}
@@ -0,0 +1,162 @@
+package genetic_algorithm
+
+import (
+	. "gopkg.in/check.v1"
+	"time"
+)
+
+type StopCriterionSuite struct{}
+
+var _ = Suite(&StopCriterionSuite{})
+
+func (s *StopCriterionSuite) TestCombinators(c *C) {
+	yes := stopCriterionFunc(func(StatisticsDataInterface) bool { return true })
+	no := stopCriterionFunc(func(StatisticsDataInterface) bool { return false })
+
+	c.Assert(AnyOf(no, yes).ShouldStop(nil), Equals, true)
+	c.Assert(AnyOf(no, no).ShouldStop(nil), Equals, false)
+	c.Assert(AllOf(yes, yes).ShouldStop(nil), Equals, true)
+	c.Assert(AllOf(yes, no).ShouldStop(nil), Equals, false)
+	c.Assert(NoneOf(no).ShouldStop(nil), Equals, true)
+	c.Assert(NoneOf(no, yes).ShouldStop(nil), Equals, false)
+	c.Assert(AnyOf(AllOf(yes, NoneOf(no)), no).ShouldStop(nil), Equals, true)
+}
+func (s *StopCriterionSuite) TestCombinators_AskAllCriteria(c *C) {
+	asked := 0
+	counting := stopCriterionFunc(func(StatisticsDataInterface) bool {
+		asked++
+		return true
+	})
+
+	AnyOf(counting, counting).ShouldStop(nil)
+	AllOf(stopCriterionFunc(func(StatisticsDataInterface) bool { return false }), counting).ShouldStop(nil)
+
+	c.Assert(asked, Equals, 3)
+}
+func (s *StopCriterionSuite) TestCombinators_SetupAndCheckChildren(c *C) {
+	options := NewStatisticsDefaultOptions()
+	criterion := AnyOf(NewEvaluationBudgetStopCriterion(10), AllOf(NoneOf(NewImprovementStopCriterion(5, 0.01))))
+
+	criterion.Setup(options)
+
+	c.Assert(options.trackEvaluations, Equals, true)
+	c.Assert(options.trackMinCosts, Equals, true)
+	c.Assert(options.trackGensWoImprv, Equals, false)
+
+	c.Assert(criterion.Check(), IsNil)
+	c.Assert(AllOf(NoneOf(NewEvaluationBudgetStopCriterion(0))).Check(), NotNil)
+	c.Assert(AnyOf(nil).Check(), NotNil)
+}
+func (s *StopCriterionSuite) TestWallClock(c *C) {
+	now := time.Unix(0, 0)
+	criterion := NewWallClockStopCriterion(time.Minute)
+	criterion.now = func() time.Time { return now }
+
+	criterion.Setup(nil)
+	now = now.Add(59 * time.Second)
+	c.Assert(criterion.ShouldStop(nil), Equals, false)
+	now = now.Add(time.Second)
+	c.Assert(criterion.ShouldStop(nil), Equals, true)
+
+	criterion.Setup(nil)
+	c.Assert(criterion.ShouldStop(nil), Equals, false)
+}
+func (s *StopCriterionSuite) TestEvaluationBudget(c *C) {
+	options := NewStatisticsDefaultOptions()
+	criterion := NewEvaluationBudgetStopCriterion(10)
+	criterion.Setup(options)
+
+	stats := NewStatisticsDefault(options).(*StatisticsDefault)
+	stats.OnEvaluations(9, 5)
+	c.Assert(criterion.ShouldStop(stats), Equals, false)
+	stats.OnEvaluations(1, 0)
+	c.Assert(criterion.ShouldStop(stats), Equals, true)
+}
+func (s *StopCriterionSuite) TestDiversity(c *C) {
+	same := Chromosomes{
+		NewBinaryChromosome(BinaryGenes{true, false}),
+		NewBinaryChromosome(BinaryGenes{true, false}),
+	}
+	different := Chromosomes{
+		NewBinaryChromosome(BinaryGenes{true, false}),
+		NewBinaryChromosome(BinaryGenes{false, true}),
+	}
+
+	measures := []DiversityMeasure{GenotypicDiversity, MeanLocusEntropy, UniqueGenotypes}
+	for _, measure := range measures {
+		options := NewStatisticsDefaultOptions()
+		criterion := NewDiversityStopCriterion(measure, 0)
+		if measure == UniqueGenotypes {
+			criterion = NewDiversityStopCriterion(measure, 1)
+		}
+		criterion.Setup(options)
+
+		stats := NewStatisticsDefault(options).(*StatisticsDefault)
+		stats.OnGeneration(different)
+		c.Assert(criterion.ShouldStop(stats), Equals, false)
+		stats.OnGeneration(same)
+		c.Assert(criterion.ShouldStop(stats), Equals, true)
+	}
+
+	c.Assert(NewDiversityStopCriterion(DiversityMeasure(10), 0).Check(), NotNil)
+}
+func (s *StopCriterionSuite) TestDiversity_RejectsGenesWithoutDistance(c *C) {
+	options := NewStatisticsDefaultOptions()
+	NewDiversityStopCriterion(GenotypicDiversity, 0).Setup(options)
+
+	c.Assert(checkGenesDistance(options, Chromosomes{NewBinaryChromosome(BinaryGenes{true})}), IsNil)
+	c.Assert(checkGenesDistance(options, Chromosomes{newCustomChromosome(1, 2)}), FitsTypeOf, &ConfigError{})
+	c.Assert(checkGenesDistance(NewStatisticsDefaultOptions(), Chromosomes{newCustomChromosome(1, 2)}), IsNil)
+}
+func (s *StopCriterionSuite) TestImprovement(c *C) {
+	options := NewStatisticsDefaultOptions()
+	criterion := NewImprovementStopCriterion(2, 0.1)
+	criterion.Setup(options)
+
+	stats := NewStatisticsDefault(options).(*StatisticsDefault)
+	for _, cost := range []float64{10, 9, 8.5, 8, 7.5} {
+		stats.OnGeneration(chromosomesWithCosts(cost))
+	}
+	// 8.5 -> 7.5 is more than 10%
+	c.Assert(criterion.ShouldStop(stats), Equals, false)
+
+	stats.OnGeneration(chromosomesWithCosts(7.4))
+	// 8 -> 7.4 is less than 10%
+	c.Assert(criterion.ShouldStop(stats), Equals, true)
+}
+func (s *StopCriterionSuite) TestSignal(c *C) {
+	signal := make(chan struct{}, 1)
+	criterion := NewSignalStopCriterion(signal)
+
+	criterion.Setup(nil)
+	c.Assert(criterion.ShouldStop(nil), Equals, false)
+
+	signal <- struct{}{}
+	c.Assert(criterion.ShouldStop(nil), Equals, true)
+	c.Assert(criterion.ShouldStop(nil), Equals, true)
+
+	criterion.Setup(nil)
+	c.Assert(criterion.ShouldStop(nil), Equals, false)
+
+	close(signal)
+	c.Assert(criterion.ShouldStop(nil), Equals, true)
+}
+func (s *StopCriterionSuite) TestOptimizerWithComposedCriteria(c *C) {
+	_, data := NewSimpleOptimizer().Elitism(1).CrossoverProbability(0.8).
+		Initializer(NewBinaryRandomInitializer()).
+		Selector(NewSimpleTournamentSelector(2)).
+		Crossover(NewTwoPointCrossover(NewEmptyBinaryChromosome)).
+		Mutator(NewBinaryMutator(0.05)).
+		CostFunction(countOnes).
+		StopCriterion(AnyOf(
+			NewEvaluationBudgetStopCriterion(100),
+			NewStopCriterionDefault().Max_Generations(1000))).
+		PopSize(10).
+		ChromSize(20).
+		Seed(1).
+		Optimize()
+
+	stats := data.(StatisticsDataDefault)
+	c.Assert(stats.Evaluations() >= 100, Equals, true)
+	c.Assert(stats.Evaluations() < 110, Equals, true)
+}
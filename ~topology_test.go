@@ -0,0 +1,49 @@
+package genetic_algorithm
+
+import (
+	. "gopkg.in/check.v1"
+	"math/rand"
+)
+
+type TopologySuite struct{}
+
+var _ = Suite(&TopologySuite{})
+
+func (s *TopologySuite) TestRing(c *C) {
+	topology := NewRingTopology()
+
+	c.Assert(topology.Neighbours(0, 3), DeepEquals, []int{1})
+	c.Assert(topology.Neighbours(2, 3), DeepEquals, []int{0})
+	c.Assert(topology.Neighbours(0, 1), HasLen, 0)
+}
+func (s *TopologySuite) TestFullyConnected(c *C) {
+	topology := NewFullyConnectedTopology()
+
+	c.Assert(topology.Neighbours(1, 4), DeepEquals, []int{0, 2, 3})
+	c.Assert(topology.Neighbours(0, 1), HasLen, 0)
+}
+func (s *TopologySuite) TestRandom(c *C) {
+	topology := NewRandomTopology(2)
+	topology.SetRand(rand.New(NewRandomSource(1)))
+
+	for i := 0; i < 100; i++ {
+		neighbours := topology.Neighbours(1, 4)
+		c.Assert(neighbours, HasLen, 2)
+		c.Assert(neighbours[0], Not(Equals), neighbours[1])
+		for _, neighbour := range neighbours {
+			c.Assert(neighbour != 1 && neighbour >= 0 && neighbour < 4, Equals, true)
+		}
+	}
+
+	c.Assert(topology.Neighbours(0, 2), DeepEquals, []int{1})
+}
+func (s *TopologySuite) TestCustom(c *C) {
+	topology := NewCustomTopology([][]int{{1, 2}, {}, {0}})
+	c.Assert(topology.Check(), IsNil)
+
+	c.Assert(topology.Neighbours(0, 3), DeepEquals, []int{1, 2})
+	c.Assert(topology.Neighbours(1, 3), HasLen, 0)
+
+	c.Assert(NewCustomTopology([][]int{{0}}).Check(), NotNil)
+	c.Assert(NewCustomTopology([][]int{{2}, {}}).Check(), NotNil)
+}